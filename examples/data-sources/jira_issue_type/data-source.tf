@@ -0,0 +1,12 @@
+data "jira_issue_type" "bug" {
+  name        = "Bug"
+  project_key = "INFRA"
+}
+
+resource "jira_workflow_scheme" "infra" {
+  name = "Infrastructure Workflows"
+
+  issue_type_mappings = {
+    (data.jira_issue_type.bug.id) = "Bug Workflow"
+  }
+}
@@ -0,0 +1,6 @@
+data "jira_issue_types" "all" {}
+
+resource "jira_issue_type_scheme" "all" {
+  name           = "All Issue Types"
+  issue_type_ids = [for issue_type in data.jira_issue_types.all.issue_types : issue_type.id if !issue_type.is_subtask]
+}
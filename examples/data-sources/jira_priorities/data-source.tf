@@ -0,0 +1,6 @@
+data "jira_priorities" "all" {}
+
+resource "jira_priority_scheme" "all" {
+  name         = "All Priorities"
+  priority_ids = data.jira_priorities.all.priorities[*].id
+}
@@ -0,0 +1,9 @@
+data "jira_priority" "high" {
+  name = "High"
+}
+
+resource "jira_priority_scheme" "infra" {
+  name                = "Infrastructure Priorities"
+  priority_ids        = [data.jira_priority.high.id]
+  default_priority_id = data.jira_priority.high.id
+}
@@ -0,0 +1,20 @@
+data "jira_project" "infra" {
+  key = "INFRA"
+}
+
+resource "jira_project" "shared" {
+  key                             = "SHRD"
+  name                            = "Shared Configuration"
+  lead                            = data.jira_project.infra.lead
+  shared_configuration_project_id = data.jira_project.infra.project_id
+}
+
+resource "jira_filter" "infra" {
+  name = "Infrastructure Issues"
+  jql  = "project = INFRA"
+
+  permissions {
+    type       = "project"
+    project_id = data.jira_project.infra.id
+  }
+}
@@ -0,0 +1,8 @@
+data "jira_resolution" "done" {
+  name = "Done"
+}
+
+resource "jira_resolution" "obsolete" {
+  name            = "Obsolete"
+  replace_with_id = data.jira_resolution.done.id
+}
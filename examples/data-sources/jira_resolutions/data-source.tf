@@ -0,0 +1,5 @@
+data "jira_resolutions" "all" {}
+
+output "resolutions" {
+  value = { for resolution in data.jira_resolutions.all.resolutions : resolution.name => resolution.id }
+}
@@ -0,0 +1,11 @@
+data "jira_status" "in_review" {
+  name        = "In Review"
+  project_key = "INFRA"
+}
+
+resource "jira_issue" "review" {
+  issue_type  = "Task"
+  project_key = "INFRA"
+  summary     = "Review the infrastructure changes"
+  state       = data.jira_status.in_review.id
+}
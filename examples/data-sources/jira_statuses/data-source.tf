@@ -0,0 +1,7 @@
+data "jira_statuses" "infra" {
+  project_key = "INFRA"
+}
+
+output "done_statuses" {
+  value = [for status in data.jira_statuses.infra.statuses : status.name if status.status_category == "DONE"]
+}
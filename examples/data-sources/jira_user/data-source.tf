@@ -0,0 +1,15 @@
+data "jira_user" "lead" {
+  email = "jane.doe@example.org"
+}
+
+resource "jira_project" "example" {
+  key  = "PROJ"
+  name = "Example Project"
+  lead = data.jira_user.lead.name
+}
+
+resource "jira_project_membership" "member" {
+  project_key = jira_project.example.key
+  role_id     = 10001
+  username    = data.jira_user.lead.name
+}
@@ -3,4 +3,5 @@ provider "jira" {
   user = "xxxx"                      # Can also be set using the JIRA_USER environment variable
   password = "xxxx"                  # Can also be set using the JIRA_PASSWORD environment variable
   token = "xxxx"                      # Can also be set using the JIRA_TOKEN environment variable
+  api_version = 3                    # Exchange descriptions and comments in Atlassian Document Format (Jira Cloud)
 }
@@ -8,3 +8,9 @@ resource "jira_comment" "example_comment" {
   body = "Commented using terraform"
   issue_key = "${jira_issue.example.issue_key}"
 }
+
+// With api_version = 3, markdown is converted into Atlassian Document Format
+resource "jira_comment" "markdown_comment" {
+  body = "Commented using **Terraform**, see [the docs](https://example.org)"
+  issue_key = "${jira_issue.example.issue_key}"
+}
@@ -0,0 +1,16 @@
+resource "jira_custom_field" "team" {
+  name         = "Team"
+  description  = "Team responsible for the issue"
+  type         = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+  searcher_key = "com.atlassian.jira.plugin.system.customfieldtypes:textsearcher"
+}
+
+resource "jira_issue" "example" {
+  issue_type  = "Task"
+  project_key = "PRJ"
+  summary     = "Created using Terraform"
+
+  fields = {
+    (jira_custom_field.team.id) = "Platform"
+  }
+}
@@ -0,0 +1,19 @@
+resource "jira_custom_field" "team" {
+  name = "Team"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+}
+
+data "jira_project" "prj" {
+  key = "PRJ"
+}
+
+resource "jira_custom_field_context" "team" {
+  field_id      = jira_custom_field.team.id
+  name          = "PRJ Tasks"
+  project_ids   = [data.jira_project.prj.project_id]
+  default_value = "Platform"
+
+  issue_type_ids = [
+    for issue_type in data.jira_project.prj.issue_types : issue_type.id if issue_type.name == "Task"
+  ]
+}
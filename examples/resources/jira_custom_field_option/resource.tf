@@ -0,0 +1,28 @@
+data "jira_field" "component" {
+  name = "Affected Component"
+}
+
+resource "jira_custom_field_context" "component" {
+  field_id = data.jira_field.component.id
+  name     = "Default Context"
+}
+
+// Options are shown in the order of the option blocks
+resource "jira_custom_field_option" "component" {
+  field_id   = data.jira_field.component.id
+  context_id = jira_custom_field_context.component.context_id
+
+  option {
+    value   = "Backend"
+    default = true
+  }
+
+  option {
+    value = "Frontend"
+  }
+
+  option {
+    value    = "Legacy"
+    disabled = true
+  }
+}
@@ -0,0 +1,16 @@
+resource "jira_field_configuration" "engineering" {
+  name        = "Engineering"
+  description = "Bugs need a description"
+
+  field {
+    field_id    = "description"
+    required    = true
+    description = "Steps to reproduce, expected and actual behaviour"
+    renderer    = "wiki-renderer"
+  }
+
+  field {
+    field_id = "duedate"
+    hidden   = true
+  }
+}
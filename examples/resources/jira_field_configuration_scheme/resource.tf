@@ -0,0 +1,20 @@
+resource "jira_issue_type" "bug" {
+  name = "Defect"
+}
+
+resource "jira_field_configuration_scheme" "engineering" {
+  name = "Engineering"
+
+  issue_type_mappings = {
+    (jira_issue_type.bug.id) = jira_field_configuration.engineering.id
+  }
+}
+
+resource "jira_project" "engineering" {
+  key                        = "ENG"
+  name                       = "Engineering"
+  lead                       = "admin"
+  project_type_key           = "software"
+  project_template_key       = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  field_configuration_scheme = jira_field_configuration_scheme.engineering.id
+}
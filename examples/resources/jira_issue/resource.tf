@@ -15,6 +15,33 @@ resource "jira_issue" "example" {
   state_transition = 31 
 }
 
+resource "jira_issue" "status_example" {
+  issue_type  = "Task"
+  project_key = "PROJ"
+  summary     = "Moved to Done using Terraform"
+
+  // (optional) Move the issue into the status with this name, passing
+  // intermediate statuses of the workflow if needed
+  status             = "Done"
+  resolution         = "Done"
+  transition_comment = "Closed by Terraform"
+}
+
+resource "jira_issue" "standard_fields_example" {
+  issue_type  = "Sub-task"
+  project_key = "PROJ"
+  summary     = "Created with standard fields using Terraform"
+  parent      = jira_issue.example.issue_key
+
+  priority          = "High"
+  components        = ["Backend"]
+  fix_versions      = ["2.0"]
+  affects_versions  = ["1.0", "1.1"]
+  due_date          = "2024-05-01"
+  environment       = "Production"
+  original_estimate = "1d 4h"
+}
+
 data "jira_field" "epic_link" {
   name = "Epic Link"
 }
@@ -28,3 +55,35 @@ resource "jira_issue" "custom_fields_example" {
   project_key = "PROJ"
 }
 
+resource "jira_issue" "typed_fields_example" {
+  issue_type  = "Task"
+  summary     = "Created with typed custom fields"
+  project_key = "PROJ"
+
+  // Values in the format of the JIRA REST API, e.g. select lists, user
+  // pickers, dates and cascading selects
+  fields_json = jsonencode({
+    customfield_10010 = { value = "High" }
+    customfield_10011 = [{ value = "Backend" }, { value = "Frontend" }]
+    customfield_10012 = { name = "jdoe" }
+    customfield_10013 = "2024-05-01"
+    customfield_10014 = { value = "Europe", child = { value = "Berlin" } }
+  })
+}
+
+// Requires api_version = 3 in the provider configuration. description
+// accepts markdown as well, which is converted into the same format.
+resource "jira_issue" "adf_example" {
+  issue_type  = "Task"
+  summary     = "Created with a description in Atlassian Document Format"
+  project_key = "PROJ"
+
+  description_adf = jsonencode({
+    type    = "doc"
+    version = 1
+    content = [{
+      type    = "paragraph"
+      content = [{ type = "text", text = "Created using Terraform", marks = [{ type = "strong" }] }]
+    }]
+  })
+}
@@ -0,0 +1,18 @@
+resource "jira_issue" "example" {
+  issue_type  = "Task"
+  project_key = "PROJ"
+  summary     = "Created using Terraform"
+}
+
+// Uploads a local file, the attachment is replaced when the file changes
+resource "jira_issue_attachment" "diagram" {
+  issue_key = jira_issue.example.issue_key
+  source    = "${path.module}/diagram.png"
+}
+
+// Uploads inline content, e.g. generated by Terraform
+resource "jira_issue_attachment" "runbook" {
+  issue_key = jira_issue.example.issue_key
+  filename  = "runbook.md"
+  content   = templatefile("${path.module}/runbook.md.tpl", { issue = jira_issue.example.issue_key })
+}
@@ -0,0 +1,43 @@
+resource "jira_group" "security" {
+  name = "security-team"
+}
+
+resource "jira_issue_security_scheme" "default" {
+  name = "Confidential"
+}
+
+resource "jira_issue_security_level" "internal" {
+  scheme_id   = jira_issue_security_scheme.default.id
+  name        = "Internal"
+  description = "Visible to the security team, the reporter and the assignee"
+  default     = true
+
+  member {
+    type      = "group"
+    parameter = jira_group.security.name
+  }
+
+  member {
+    type = "reporter"
+  }
+
+  member {
+    type = "assignee"
+  }
+}
+
+resource "jira_project" "engineering" {
+  key                   = "ENG"
+  name                  = "Engineering"
+  lead                  = "admin"
+  project_type_key      = "software"
+  project_template_key  = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_security_scheme = jira_issue_security_scheme.default.id
+}
+
+resource "jira_issue" "vulnerability" {
+  issue_type     = "Bug"
+  project_key    = jira_project.engineering.key
+  summary        = "Confidential vulnerability report"
+  security_level = jira_issue_security_level.internal.level_id
+}
@@ -0,0 +1,13 @@
+resource "jira_issue_security_scheme" "default" {
+  name        = "Confidential"
+  description = "Restricts the visibility of confidential issues"
+}
+
+resource "jira_project" "engineering" {
+  key                   = "ENG"
+  name                  = "Engineering"
+  lead                  = "admin"
+  project_type_key      = "software"
+  project_template_key  = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_security_scheme = jira_issue_security_scheme.default.id
+}
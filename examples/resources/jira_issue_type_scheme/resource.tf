@@ -0,0 +1,23 @@
+resource "jira_issue_type" "story" {
+  name = "User Story"
+}
+
+resource "jira_issue_type" "defect" {
+  name = "Defect"
+}
+
+resource "jira_issue_type_scheme" "engineering" {
+  name                  = "Engineering"
+  description           = "The issue types used by engineering teams"
+  issue_type_ids        = [jira_issue_type.story.id, jira_issue_type.defect.id]
+  default_issue_type_id = jira_issue_type.story.id
+}
+
+resource "jira_project" "engineering" {
+  key                  = "ENG"
+  name                 = "Engineering"
+  lead                 = "admin"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_type_scheme    = jira_issue_type_scheme.engineering.id
+}
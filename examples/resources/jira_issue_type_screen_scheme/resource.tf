@@ -0,0 +1,21 @@
+resource "jira_issue_type" "story" {
+  name = "User Story"
+}
+
+resource "jira_issue_type_screen_scheme" "engineering" {
+  name                     = "Engineering"
+  default_screen_scheme_id = 1 # Default Screen Scheme
+
+  issue_type_mappings = {
+    (jira_issue_type.story.id) = jira_screen_scheme.story.id
+  }
+}
+
+resource "jira_project" "engineering" {
+  key                      = "ENG"
+  name                     = "Engineering"
+  lead                     = "admin"
+  project_type_key         = "software"
+  project_template_key     = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_type_screen_scheme = jira_issue_type_screen_scheme.engineering.id
+}
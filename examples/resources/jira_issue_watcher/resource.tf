@@ -0,0 +1,11 @@
+resource "jira_issue" "example" {
+  issue_type  = "Task"
+  project_key = "PROJ"
+  summary     = "Created using Terraform"
+}
+
+// Other watchers of the issue are kept
+resource "jira_issue_watcher" "example" {
+  issue_key = jira_issue.example.issue_key
+  username  = "alice"
+}
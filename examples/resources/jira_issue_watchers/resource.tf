@@ -0,0 +1,11 @@
+resource "jira_issue" "compliance" {
+  issue_type  = "Task"
+  project_key = "PROJ"
+  summary     = "Quarterly access review"
+}
+
+// All other watchers are removed, including the reporter
+resource "jira_issue_watchers" "compliance" {
+  issue_key = jira_issue.compliance.issue_key
+  users     = ["alice", "bob"]
+}
@@ -0,0 +1,35 @@
+resource "jira_role" "developers" {
+  name = "Developers"
+}
+
+resource "jira_notification_scheme" "default" {
+  name        = "Engineering"
+  description = "Notifications of engineering projects"
+
+  // Issue Created
+  notification {
+    event_id  = 1
+    type      = "ProjectRole"
+    parameter = jira_role.developers.id
+  }
+
+  // Issue Updated
+  notification {
+    event_id = 2
+    type     = "CurrentAssignee"
+  }
+
+  notification {
+    event_id = 2
+    type     = "AllWatchers"
+  }
+}
+
+resource "jira_project" "engineering" {
+  key                  = "ENG"
+  name                 = "Engineering"
+  lead                 = "admin"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  notification_scheme  = jira_notification_scheme.default.id
+}
@@ -0,0 +1,43 @@
+resource "jira_group" "developers" {
+  name = "developers"
+}
+
+resource "jira_role" "reviewers" {
+  name = "Reviewers"
+}
+
+resource "jira_permission_scheme" "default" {
+  name        = "Engineering"
+  description = "Permissions of engineering projects"
+
+  grant {
+    permission       = "BROWSE_PROJECTS"
+    holder_type      = "group"
+    holder_parameter = jira_group.developers.name
+  }
+
+  grant {
+    permission       = "TRANSITION_ISSUES"
+    holder_type      = "projectRole"
+    holder_parameter = jira_role.reviewers.id
+  }
+
+  grant {
+    permission  = "EDIT_ISSUES"
+    holder_type = "reporter"
+  }
+
+  grant {
+    permission  = "ADMINISTER_PROJECTS"
+    holder_type = "projectLead"
+  }
+}
+
+resource "jira_project" "engineering" {
+  key                  = "ENG"
+  name                 = "Engineering"
+  lead                 = "admin"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  permission_scheme    = jira_permission_scheme.default.id
+}
@@ -0,0 +1,5 @@
+resource "jira_priority" "blocker" {
+  name        = "Blocker"
+  description = "Blocks development and/or testing work"
+  color       = "#cc0000"
+}
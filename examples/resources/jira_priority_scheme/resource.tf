@@ -0,0 +1,14 @@
+resource "jira_priority_scheme" "engineering" {
+  name                = "Engineering"
+  priority_ids        = [jira_priority.blocker.id, "3", "4"]
+  default_priority_id = "3" # Medium
+}
+
+resource "jira_project" "engineering" {
+  key                  = "ENG"
+  name                 = "Engineering"
+  lead                 = "admin"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  priority_scheme      = jira_priority_scheme.engineering.id
+}
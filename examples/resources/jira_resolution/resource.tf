@@ -0,0 +1,4 @@
+resource "jira_resolution" "cannot_reproduce" {
+  name        = "Cannot Reproduce"
+  description = "All attempts at reproducing this issue failed"
+}
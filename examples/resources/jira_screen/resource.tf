@@ -0,0 +1,18 @@
+data "jira_field" "story_points" {
+  name = "Story Points"
+}
+
+resource "jira_screen" "story" {
+  name        = "Story Screen"
+  description = "Shown when working with stories"
+
+  tab {
+    name   = "General"
+    fields = ["summary", "description", data.jira_field.story_points.id]
+  }
+
+  tab {
+    name   = "Planning"
+    fields = ["assignee", "duedate", "fixVersions"]
+  }
+}
@@ -0,0 +1,14 @@
+resource "jira_screen" "create" {
+  name = "Create Story"
+
+  tab {
+    name   = "General"
+    fields = ["summary", "description"]
+  }
+}
+
+resource "jira_screen_scheme" "story" {
+  name              = "Story Screen Scheme"
+  default_screen_id = jira_screen.story.id
+  create_screen_id  = jira_screen.create.id
+}
@@ -0,0 +1,5 @@
+resource "jira_status" "review" {
+  name        = "In Review"
+  description = "The change is being reviewed"
+  category    = "IN_PROGRESS"
+}
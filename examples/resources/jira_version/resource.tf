@@ -0,0 +1,16 @@
+resource "jira_version" "v1_0" {
+  name         = "1.0"
+  project_key  = "PRJ"
+  description  = "First public release"
+  start_date   = "2021-05-01"
+  release_date = "2021-06-30"
+
+  // Unresolved issues are moved to 1.1 once the version is released
+  released               = true
+  move_unfixed_issues_to = jira_version.v1_1.id
+}
+
+resource "jira_version" "v1_1" {
+  name        = "1.1"
+  project_key = "PRJ"
+}
@@ -0,0 +1,57 @@
+resource "jira_workflow" "simple" {
+  name        = "Simple Workflow"
+  description = "To Do, In Progress and Done"
+
+  # Status IDs can be found in the JIRA administration
+  status {
+    status_id = "1"
+  }
+
+  status {
+    status_id = "3"
+  }
+
+  status {
+    status_id = "10000"
+  }
+
+  transition {
+    name = "Create"
+    type = "initial"
+    to   = "1"
+  }
+
+  transition {
+    name = "Start Progress"
+    from = ["1"]
+    to   = "3"
+
+    condition {
+      type          = "PermissionCondition"
+      configuration = jsonencode({ permissionKey = "WORK_ON_ISSUES" })
+    }
+
+    post_function {
+      type = "AssignToCurrentUserFunction"
+    }
+  }
+
+  transition {
+    name = "Done"
+    type = "global"
+    to   = "10000"
+  }
+}
+
+# Workflows can also be defined by the JSON body of the workflow REST API
+resource "jira_workflow" "raw" {
+  name = "Raw Workflow"
+
+  definition_json = jsonencode({
+    statuses = [{ id = "1" }, { id = "10000" }]
+    transitions = [
+      { name = "Create", type = "initial", from = [], to = "1" },
+      { name = "Done", type = "directed", from = ["1"], to = "10000" },
+    ]
+  })
+}
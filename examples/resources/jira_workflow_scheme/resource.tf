@@ -0,0 +1,32 @@
+resource "jira_workflow_scheme" "engineering" {
+  name             = "Engineering"
+  default_workflow = "jira"
+
+  issue_type_mappings = {
+    # ID of the issue type => name of the workflow
+    "10001" = jira_workflow.simple.name
+  }
+
+  # Issues of projects using the scheme are moved to Done, if their status is
+  # not part of the new workflow
+  status_mapping {
+    issue_type_id = "10001"
+    status_id     = "4"
+    new_status_id = "10000"
+  }
+}
+
+resource "jira_project" "engineering" {
+  key                  = "ENG"
+  name                 = "Engineering"
+  lead                 = "admin"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  workflow_scheme      = jira_workflow_scheme.engineering.id
+
+  workflow_status_mapping {
+    issue_type_id = "10001"
+    status_id     = "4"
+    new_status_id = "10000"
+  }
+}
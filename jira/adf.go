@@ -0,0 +1,463 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ADFNode is a node of a document in Atlassian Document Format, which is used
+// for rich text by version 3 of the JIRA REST API
+type ADFNode struct {
+	Type    string                 `json:"type"`
+	Version int                    `json:"version,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Content []*ADFNode             `json:"content,omitempty"`
+	Text    string                 `json:"text,omitempty"`
+	Marks   []*ADFMark             `json:"marks,omitempty"`
+}
+
+// ADFMark formats the text of a node, e.g. as strong or as a link
+type ADFMark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+var (
+	adfMarkdownHeading = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	adfWikiHeading     = regexp.MustCompile(`^h([1-6])\.\s+(.*)$`)
+	adfRule            = regexp.MustCompile(`^(-{3,}|\*{3,})$`)
+	adfBlockquote      = regexp.MustCompile(`^(?:>\s?|bq\.\s+)(.*)$`)
+	adfBulletItem      = regexp.MustCompile(`^[-*]\s+(.*)$`)
+	adfOrderedItem     = regexp.MustCompile(`^(\d+)[.)]\s+(.*)$`)
+	adfCodeFence       = regexp.MustCompile("^(?:```\\s*([\\w+-]*)|\\{code(?::([\\w+-]+))?\\})$")
+	adfInline          = regexp.MustCompile("\\*\\*(.+?)\\*\\*|~~(.+?)~~|`([^`]+)`|\\[([^\\]]+)\\]\\(([^)\\s]+)\\)|\\*([^*\\s][^*]*?)\\*")
+)
+
+// markupToADF converts text into a document. It understands the block and
+// inline syntax of markdown, as well as headings, quotes and code blocks of
+// wiki markup.
+func markupToADF(text string) *ADFNode {
+	doc := &ADFNode{Type: "doc", Version: 1}
+	lines := strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+
+	for i := 0; i < len(lines); {
+		line := strings.TrimSpace(lines[i])
+
+		if line == "" {
+			i++
+			continue
+		}
+
+		if m := adfCodeFence.FindStringSubmatch(line); m != nil {
+			wiki := strings.HasPrefix(line, "{")
+			code := []string{}
+			for i++; i < len(lines); i++ {
+				end := strings.TrimSpace(lines[i])
+				if (wiki && end == "{code}") || (!wiki && end == "```") {
+					i++
+					break
+				}
+				code = append(code, lines[i])
+			}
+			block := &ADFNode{Type: "codeBlock"}
+			if language := m[1] + m[2]; language != "" {
+				block.Attrs = map[string]interface{}{"language": language}
+			}
+			if len(code) > 0 {
+				block.Content = []*ADFNode{{Type: "text", Text: strings.Join(code, "\n")}}
+			}
+			doc.Content = append(doc.Content, block)
+			continue
+		}
+
+		if m := adfMarkdownHeading.FindStringSubmatch(line); m != nil {
+			doc.Content = append(doc.Content, adfHeading(len(m[1]), m[2]))
+			i++
+			continue
+		}
+		if m := adfWikiHeading.FindStringSubmatch(line); m != nil {
+			level, _ := strconv.Atoi(m[1])
+			doc.Content = append(doc.Content, adfHeading(level, m[2]))
+			i++
+			continue
+		}
+
+		if adfRule.MatchString(line) {
+			doc.Content = append(doc.Content, &ADFNode{Type: "rule"})
+			i++
+			continue
+		}
+
+		if adfBlockquote.MatchString(line) {
+			quoted := []string{}
+			for ; i < len(lines); i++ {
+				m := adfBlockquote.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				if m == nil {
+					break
+				}
+				quoted = append(quoted, m[1])
+			}
+			quote := markupToADF(strings.Join(quoted, "\n"))
+			doc.Content = append(doc.Content, &ADFNode{Type: "blockquote", Content: quote.Content})
+			continue
+		}
+
+		if adfBulletItem.MatchString(line) {
+			list := &ADFNode{Type: "bulletList"}
+			for ; i < len(lines); i++ {
+				m := adfBulletItem.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				if m == nil {
+					break
+				}
+				list.Content = append(list.Content, adfListItem(m[1]))
+			}
+			doc.Content = append(doc.Content, list)
+			continue
+		}
+
+		if m := adfOrderedItem.FindStringSubmatch(line); m != nil {
+			list := &ADFNode{Type: "orderedList"}
+			if order, _ := strconv.Atoi(m[1]); order != 1 {
+				list.Attrs = map[string]interface{}{"order": order}
+			}
+			for ; i < len(lines); i++ {
+				m := adfOrderedItem.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				if m == nil {
+					break
+				}
+				list.Content = append(list.Content, adfListItem(m[2]))
+			}
+			doc.Content = append(doc.Content, list)
+			continue
+		}
+
+		// Lines of a paragraph are separated by hard breaks, as in wiki markup
+		paragraph := &ADFNode{Type: "paragraph"}
+		for ; i < len(lines) && !adfStartsBlock(lines[i]); i++ {
+			if len(paragraph.Content) > 0 {
+				paragraph.Content = append(paragraph.Content, &ADFNode{Type: "hardBreak"})
+			}
+			paragraph.Content = append(paragraph.Content, markupToADFInline(strings.TrimSpace(lines[i]))...)
+		}
+		doc.Content = append(doc.Content, paragraph)
+	}
+
+	return doc
+}
+
+// adfStartsBlock reports whether the line ends a paragraph
+func adfStartsBlock(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, block := range []*regexp.Regexp{adfCodeFence, adfMarkdownHeading, adfWikiHeading, adfRule, adfBlockquote, adfBulletItem, adfOrderedItem} {
+		if block.MatchString(line) {
+			return true
+		}
+	}
+	return line == ""
+}
+
+func adfHeading(level int, text string) *ADFNode {
+	return &ADFNode{
+		Type:    "heading",
+		Attrs:   map[string]interface{}{"level": level},
+		Content: markupToADFInline(text),
+	}
+}
+
+func adfListItem(text string) *ADFNode {
+	return &ADFNode{
+		Type:    "listItem",
+		Content: []*ADFNode{{Type: "paragraph", Content: markupToADFInline(text)}},
+	}
+}
+
+// markupToADFInline converts text into text nodes, marking strong, emphasized,
+// struck through and code text as well as links
+func markupToADFInline(text string) []*ADFNode {
+	nodes := []*ADFNode{}
+	plain := func(s string) {
+		if s != "" {
+			nodes = append(nodes, &ADFNode{Type: "text", Text: s})
+		}
+	}
+	marked := func(s string, mark *ADFMark) {
+		for _, node := range markupToADFInline(s) {
+			node.Marks = append(node.Marks, mark)
+			nodes = append(nodes, node)
+		}
+	}
+
+	start := 0
+	for _, m := range adfInline.FindAllStringSubmatchIndex(text, -1) {
+		plain(text[start:m[0]])
+		start = m[1]
+
+		switch {
+		case m[2] >= 0:
+			marked(text[m[2]:m[3]], &ADFMark{Type: "strong"})
+		case m[4] >= 0:
+			marked(text[m[4]:m[5]], &ADFMark{Type: "strike"})
+		case m[6] >= 0:
+			nodes = append(nodes, &ADFNode{Type: "text", Text: text[m[6]:m[7]], Marks: []*ADFMark{{Type: "code"}}})
+		case m[8] >= 0:
+			marked(text[m[8]:m[9]], &ADFMark{Type: "link", Attrs: map[string]interface{}{"href": text[m[10]:m[11]]}})
+		case m[12] >= 0:
+			marked(text[m[12]:m[13]], &ADFMark{Type: "em"})
+		}
+	}
+	plain(text[start:])
+
+	return nodes
+}
+
+// adfToMarkup renders a document as markdown. Nodes without a markdown
+// notation, like mentions or panels, are reduced to their text.
+func adfToMarkup(node *ADFNode) string {
+	if node == nil {
+		return ""
+	}
+
+	switch node.Type {
+	case "text":
+		return adfMarkText(node.Text, node.Marks)
+	case "hardBreak":
+		return "\n"
+	case "mention", "emoji":
+		for _, attr := range []string{"text", "shortName"} {
+			if text, ok := node.Attrs[attr].(string); ok {
+				return text
+			}
+		}
+		return ""
+	case "paragraph":
+		return adfInlineMarkup(node.Content)
+	case "heading":
+		level := 1
+		if l, ok := node.Attrs["level"].(float64); ok {
+			level = int(l)
+		}
+		return fmt.Sprintf("%s %s", strings.Repeat("#", level), adfInlineMarkup(node.Content))
+	case "rule":
+		return "---"
+	case "codeBlock":
+		language, _ := node.Attrs["language"].(string)
+		return fmt.Sprintf("```%s\n%s\n```", language, adfInlineMarkup(node.Content))
+	case "blockquote":
+		lines := strings.Split(adfBlockMarkup(node.Content), "\n")
+		for i := range lines {
+			lines[i] = "> " + lines[i]
+		}
+		return strings.Join(lines, "\n")
+	case "bulletList", "orderedList":
+		order := 1
+		if o, ok := node.Attrs["order"].(float64); ok {
+			order = int(o)
+		}
+		items := make([]string, 0, len(node.Content))
+		for i, item := range node.Content {
+			prefix := "- "
+			if node.Type == "orderedList" {
+				prefix = fmt.Sprintf("%d. ", order+i)
+			}
+			text := strings.Replace(adfBlockMarkup(item.Content), "\n", "\n  ", -1)
+			items = append(items, prefix+text)
+		}
+		return strings.Join(items, "\n")
+	}
+
+	if len(node.Content) > 0 && node.Content[0].Type == "text" {
+		return adfInlineMarkup(node.Content)
+	}
+	return adfBlockMarkup(node.Content)
+}
+
+// adfBlockMarkup renders block nodes separated by blank lines
+func adfBlockMarkup(nodes []*ADFNode) string {
+	blocks := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		blocks = append(blocks, adfToMarkup(node))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func adfInlineMarkup(nodes []*ADFNode) string {
+	var text strings.Builder
+	for _, node := range nodes {
+		text.WriteString(adfToMarkup(node))
+	}
+	return text.String()
+}
+
+// adfMarkText wraps text in the markdown of its marks. The marks are applied
+// in a fixed order, so the rendering doesn't depend on the order JIRA returns.
+func adfMarkText(text string, marks []*ADFMark) string {
+	byType := make(map[string]*ADFMark)
+	for _, mark := range marks {
+		byType[mark.Type] = mark
+	}
+	if _, ok := byType["code"]; ok {
+		text = "`" + text + "`"
+	}
+	if _, ok := byType["em"]; ok {
+		text = "*" + text + "*"
+	}
+	if _, ok := byType["strong"]; ok {
+		text = "**" + text + "**"
+	}
+	if _, ok := byType["strike"]; ok {
+		text = "~~" + text + "~~"
+	}
+	if link, ok := byType["link"]; ok {
+		text = fmt.Sprintf("[%s](%v)", text, link.Attrs["href"])
+	}
+	return text
+}
+
+// decodeADF decodes a document. null and empty values result in nil.
+func decodeADF(raw []byte) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var document interface{}
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// adfContains reports whether the document returned by JIRA matches the
+// expected document. Attributes JIRA adds to the nodes, e.g. localId, are
+// ignored. Unlike field values, the order of the content matters.
+func adfContains(actual, expected interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if !adfContains(a[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !adfContains(a[i], e[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(actual, expected)
+}
+
+func validateADF(v interface{}, k string) ([]string, []error) {
+	document, err := decodeADF([]byte(v.(string)))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s needs to be a JSON document in Atlassian Document Format: %s", k, err)}
+	}
+	if doc, ok := document.(map[string]interface{}); !ok || doc["type"] != "doc" {
+		return nil, []error{fmt.Errorf("%s needs to be a document in Atlassian Document Format, with type doc at its root", k)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentADF suppresses differences in formatting and attributes
+// JIRA adds to the document
+func suppressEquivalentADF(k, old, new string, d *schema.ResourceData) bool {
+	actual, err := decodeADF([]byte(old))
+	if err != nil || actual == nil {
+		return false
+	}
+	expected, err := decodeADF([]byte(new))
+	if err != nil {
+		return false
+	}
+	return adfContains(actual, expected)
+}
+
+// expandRichText returns the document of a rich text attribute for version 3
+// of the API. The document of adfKey is used as it is, the text of textKey is
+// converted. It returns nil if both are empty.
+func expandRichText(d *schema.ResourceData, textKey, adfKey string) (interface{}, error) {
+	if document := d.Get(adfKey).(string); document != "" {
+		return decodeADF([]byte(document))
+	}
+	if text := d.Get(textKey).(string); text != "" {
+		return markupToADF(text), nil
+	}
+	return nil, nil
+}
+
+// flattenRichText sets the rich text attributes from a document returned by
+// version 3 of the API. If the document is managed in adfKey, which is
+// optional, it is stored as JSON. Otherwise the text in textKey is kept as
+// long as it converts into the document, so notations that differ from the
+// rendering don't cause diffs. Changed documents are rendered as markdown.
+func flattenRichText(d *schema.ResourceData, textKey, adfKey string, raw json.RawMessage) error {
+	document, err := decodeADF(raw)
+	if err != nil {
+		return err
+	}
+
+	if adfKey != "" && d.Get(adfKey).(string) != "" {
+		encoded := ""
+		if document != nil {
+			b, err := json.Marshal(document)
+			if err != nil {
+				return err
+			}
+			encoded = string(b)
+		}
+		d.Set(adfKey, encoded)
+		return nil
+	}
+
+	if document == nil {
+		d.Set(textKey, "")
+		return nil
+	}
+
+	if text := d.Get(textKey).(string); text != "" {
+		encoded, err := json.Marshal(markupToADF(text))
+		if err != nil {
+			return err
+		}
+		if expected, _ := decodeADF(encoded); adfContains(document, expected) {
+			return nil
+		}
+	}
+
+	node := &ADFNode{}
+	if err := json.Unmarshal(raw, node); err != nil {
+		return err
+	}
+	d.Set(textKey, adfToMarkup(node))
+	return nil
+}
+
+// checkADFAPIVersion rejects documents in adfKey unless the provider uses
+// version 3 of the API
+func checkADFAPIVersion(config *Config, d *schema.ResourceData, adfKey string) diag.Diagnostics {
+	if _, ok := d.GetOk(adfKey); ok && config.apiVersion != 3 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s requires api_version 3 of the provider", adfKey),
+			AttributePath: cty.GetAttrPath(adfKey),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarkupToADF(t *testing.T) {
+	cases := []struct {
+		text     string
+		document string
+	}{
+		{"first\nsecond", `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"first"},{"type":"hardBreak"},{"type":"text","text":"second"}]}]}`},
+		{"h3. Title", `{"type":"doc","version":1,"content":[{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Title"}]}]}`},
+		{"a **b** `c`", `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"a "},{"type":"text","text":"b","marks":[{"type":"strong"}]},{"type":"text","text":" "},{"type":"text","text":"c","marks":[{"type":"code"}]}]}]}`},
+		{"[*x*](https://example.org)", `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"x","marks":[{"type":"em"},{"type":"link","attrs":{"href":"https://example.org"}}]}]}]}`},
+		{"3. c\n4. d", `{"type":"doc","version":1,"content":[{"type":"orderedList","attrs":{"order":3},"content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"c"}]}]},{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"d"}]}]}]}]}`},
+		{"{code:go}\nx := 1\n{code}", `{"type":"doc","version":1,"content":[{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"x := 1"}]}]}`},
+	}
+
+	for _, c := range cases {
+		encoded, err := json.Marshal(markupToADF(c.text))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(encoded) != c.document {
+			t.Errorf("expected %q to convert into %s, got %s", c.text, c.document, encoded)
+		}
+	}
+}
+
+func TestADFToMarkup(t *testing.T) {
+	texts := []string{
+		"# Title\n\nSome **strong**, *emphasized* and ~~struck~~ text\nwith a [link](https://example.org)",
+		"- one\n- two\n\n1. first\n2. second",
+		"> quoted\n> \n> text\n\n---\n\n```go\nx := 1\n```",
+	}
+
+	for _, text := range texts {
+		if rendered := adfToMarkup(markupToADF(text)); rendered != text {
+			t.Errorf("expected %q to render as itself, got %q", text, rendered)
+		}
+	}
+}
+
+func TestSuppressEquivalentADF(t *testing.T) {
+	cases := []struct {
+		old, new   string
+		equivalent bool
+	}{
+		{`{"type":"doc","content":[{"type":"rule","attrs":{"localId":"1"}}]}`, `{"content":[{"type":"rule"}],"type":"doc"}`, true},
+		{`{"type":"doc","content":[{"type":"rule"},{"type":"rule"}]}`, `{"type":"doc","content":[{"type":"rule"}]}`, false},
+		{`{"type":"doc","content":[{"type":"text","text":"a"},{"type":"rule"}]}`, `{"type":"doc","content":[{"type":"rule"},{"type":"text","text":"a"}]}`, false},
+		{"", `{"type":"doc","content":[]}`, false},
+	}
+
+	for _, c := range cases {
+		if equivalent := suppressEquivalentADF("description_adf", c.old, c.new, nil); equivalent != c.equivalent {
+			t.Errorf("expected %s and %s to be equivalent: %t", c.old, c.new, c.equivalent)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"net/http"
 	"sync"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +14,9 @@ import (
 type Config struct {
 	jiraClient *jira.Client
 	jiraLock   sync.Mutex
+
+	// apiVersion is the version of the REST API used for rich text
+	apiVersion int
 }
 
 func (c *Config) createAndAuthenticateClient(d *schema.ResourceData) error {
@@ -20,14 +24,25 @@ func (c *Config) createAndAuthenticateClient(d *schema.ResourceData) error {
 
 	var httpClient *http.Client
 
+	// Parsing cannot fail, the values have already been validated
+	baseBackoff, _ := time.ParseDuration(d.Get("retry_base_backoff").(string))
+	maxBackoff, _ := time.ParseDuration(d.Get("retry_max_backoff").(string))
+
+	retries := &retryTransport{
+		MaxRetries:  d.Get("max_retries").(int),
+		BaseBackoff: baseBackoff,
+		MaxBackoff:  maxBackoff,
+	}
+
 	token, ok := d.GetOk("token")
 	if ok {
-		transport := jira.BearerAuthTransport{Token: token.(string)}
+		transport := jira.BearerAuthTransport{Token: token.(string), Transport: retries}
 		httpClient = transport.Client()
 	} else {
 		transport := &jira.BasicAuthTransport{
-			Username: d.Get("user").(string),
-			Password: d.Get("password").(string),
+			Username:  d.Get("user").(string),
+			Password:  d.Get("password").(string),
+			Transport: retries,
 		}
 		httpClient = transport.Client()
 	}
@@ -38,6 +53,7 @@ func (c *Config) createAndAuthenticateClient(d *schema.ResourceData) error {
 	}
 
 	c.jiraClient = jiraClient
+	c.apiVersion = d.Get("api_version").(int)
 
 	return nil
 }
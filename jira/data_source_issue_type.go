@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// issueTypeAttributes are the attributes of an issue type returned by the issue type data sources
+func issueTypeAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_subtask": &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"icon_url": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// dataSourceIssueType is used to look up a JIRA issue type by name
+func dataSourceIssueType() *schema.Resource {
+	attributes := issueTypeAttributes()
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the issue type to look up",
+	}
+	attributes["project_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Key of a project. If set, only issue types available in the project are considered",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIssueTypeRead,
+		Schema:      attributes,
+	}
+}
+
+// dataSourceIssueTypes is used to list the JIRA issue types
+func dataSourceIssueTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIssueTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of a project. If set, only issue types available in the project are listed",
+			},
+			"issue_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: issueTypeAttributes()},
+			},
+		},
+	}
+}
+
+// listIssueTypes returns all issue types, or the issue types of the issue
+// type scheme of the project if projectKey is set
+func listIssueTypes(ctx context.Context, client *jira.Client, projectKey string) ([]jira.IssueType, error) {
+	if projectKey == "" {
+		issueTypes := []jira.IssueType{}
+		err := request(ctx, client, "GET", issueTypeAPIEndpoint, nil, &issueTypes)
+		return issueTypes, err
+	}
+
+	project := &Project{}
+	err := request(ctx, client, "GET", fmt.Sprintf("%s/%s", projectAPIEndpoint, projectKey), nil, project)
+	if err != nil {
+		return nil, err
+	}
+	return project.IssueTypes, nil
+}
+
+func flattenIssueType(issueType jira.IssueType) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          issueType.ID,
+		"name":        issueType.Name,
+		"description": issueType.Description,
+		"is_subtask":  issueType.Subtask,
+		"icon_url":    issueType.IconURL,
+	}
+}
+
+// dataSourceIssueTypeRead reads issue type details using jira api
+func dataSourceIssueTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	name := d.Get("name").(string)
+	projectKey := d.Get("project_key").(string)
+
+	issueTypes, err := listIssueTypes(ctx, config.jiraClient, projectKey)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira issue types failed", nil)
+	}
+
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, name) {
+			d.SetId(issueType.ID)
+			for k, v := range flattenIssueType(issueType) {
+				d.Set(k, v)
+			}
+			return nil
+		}
+	}
+
+	return diag.Errorf("no jira issue type with name %q found%s", name, inProject(projectKey))
+}
+
+// dataSourceIssueTypesRead lists issue types using jira api
+func dataSourceIssueTypesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+
+	issueTypes, err := listIssueTypes(ctx, config.jiraClient, projectKey)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira issue types failed", nil)
+	}
+
+	values := make([]map[string]interface{}, 0, len(issueTypes))
+	for _, issueType := range issueTypes {
+		values = append(values, flattenIssueType(issueType))
+	}
+
+	d.SetId(listID(projectKey))
+	d.Set("issue_types", values)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueTypeDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeDataSourceConfig(rInt, `
+data "jira_issue_type" "global" {
+  name = "Sub-task"
+}
+
+data "jira_issue_type" "in_project" {
+  name        = jira_issue_type.foo.name
+  project_key = jira_project.foo.key
+}
+
+data "jira_issue_types" "project" {
+  project_key = jira_project.foo.key
+}
+
+data "jira_issue_types" "all" {
+  depends_on = [jira_issue_type.foo]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jira_issue_type.global", "is_subtask", "true"),
+					resource.TestCheckResourceAttrSet("data.jira_issue_type.global", "id"),
+					resource.TestCheckResourceAttrPair("data.jira_issue_type.in_project", "id", "jira_issue_type.foo", "id"),
+					resource.TestCheckResourceAttr("data.jira_issue_type.in_project", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("data.jira_issue_type.in_project", "is_subtask", "false"),
+					resource.TestCheckResourceAttr("data.jira_issue_types.project", "issue_types.#", "1"),
+					resource.TestCheckResourceAttrPair("data.jira_issue_types.project", "issue_types.0.id", "jira_issue_type.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.jira_issue_types.all", "issue_types.0.name"),
+				),
+			},
+			{
+				// Bug is not part of the issue type scheme of the project
+				Config: testAccJiraIssueTypeDataSourceConfig(rInt, `
+data "jira_issue_type" "in_project" {
+  name        = "Bug"
+  project_key = jira_project.foo.key
+}
+`),
+				ExpectError: regexp.MustCompile(`no jira issue type with name "Bug" found in project PX\d+`),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueTypeDataSourceConfig(rInt int, dataSources string) string {
+	return fmt.Sprintf(`
+resource "jira_issue_type" "foo" {
+  name        = "foo-type-%d"
+  description = "Created by Terraform"
+}
+
+resource "jira_issue_type_scheme" "foo" {
+  name                  = "foo-scheme-%d"
+  issue_type_ids        = [jira_issue_type.foo.id]
+  default_issue_type_id = jira_issue_type.foo.id
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_type_scheme    = jira_issue_type_scheme.foo.id
+}
+%s
+`, rInt, rInt, rInt, rInt, rInt%100000, dataSources)
+}
@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"context"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// priorityAttributes are the attributes of a priority returned by the priority data sources
+func priorityAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"color": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"icon_url": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// dataSourcePriority is used to look up a JIRA priority by name
+func dataSourcePriority() *schema.Resource {
+	attributes := priorityAttributes()
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the priority to look up",
+	}
+	attributes["project_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Key of a project. If set, only priorities of the priority scheme of the project are considered",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePriorityRead,
+		Schema:      attributes,
+	}
+}
+
+// dataSourcePriorities is used to list the JIRA priorities
+func dataSourcePriorities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrioritiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of a project. If set, only priorities of the priority scheme of the project are listed",
+			},
+			"priorities": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: priorityAttributes()},
+			},
+		},
+	}
+}
+
+// listPriorities returns all priorities, or the priorities of the priority
+// scheme of the project if projectKey is set. Without priority schemes, all
+// priorities are available in every project.
+func listPriorities(ctx context.Context, client *jira.Client, projectKey string) ([]jira.Priority, error) {
+	priorities := []jira.Priority{}
+	if err := request(ctx, client, "GET", priorityAPIEndpoint, nil, &priorities); err != nil {
+		return nil, err
+	}
+	if projectKey == "" {
+		return priorities, nil
+	}
+
+	scheme, err := getProjectPriorityScheme(ctx, client, projectKey)
+	if err != nil || scheme == nil {
+		return priorities, err
+	}
+
+	byID := make(map[string]jira.Priority)
+	for _, priority := range priorities {
+		byID[priority.ID] = priority
+	}
+	schemePriorities := make([]jira.Priority, 0, len(scheme.OptionIDs))
+	for _, id := range scheme.OptionIDs {
+		if priority, ok := byID[id]; ok {
+			schemePriorities = append(schemePriorities, priority)
+		}
+	}
+	return schemePriorities, nil
+}
+
+func flattenPriority(priority jira.Priority) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          priority.ID,
+		"name":        priority.Name,
+		"description": priority.Description,
+		"color":       priority.StatusColor,
+		"icon_url":    priority.IconURL,
+	}
+}
+
+// dataSourcePriorityRead reads priority details using jira api
+func dataSourcePriorityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	name := d.Get("name").(string)
+	projectKey := d.Get("project_key").(string)
+
+	priorities, err := listPriorities(ctx, config.jiraClient, projectKey)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira priorities failed", nil)
+	}
+
+	for _, priority := range priorities {
+		if strings.EqualFold(priority.Name, name) {
+			d.SetId(priority.ID)
+			for k, v := range flattenPriority(priority) {
+				d.Set(k, v)
+			}
+			return nil
+		}
+	}
+
+	return diag.Errorf("no jira priority with name %q found%s", name, inProject(projectKey))
+}
+
+// dataSourcePrioritiesRead lists priorities using jira api
+func dataSourcePrioritiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+
+	priorities, err := listPriorities(ctx, config.jiraClient, projectKey)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira priorities failed", nil)
+	}
+
+	values := make([]map[string]interface{}, 0, len(priorities))
+	for _, priority := range priorities {
+		values = append(values, flattenPriority(priority))
+	}
+
+	d.SetId(listID(projectKey))
+	d.Set("priorities", values)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPriorityDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPriorityDataSourceConfig(rInt, `
+data "jira_priority" "by_name" {
+  name = jira_priority.bar.name
+}
+
+data "jira_priority" "in_project" {
+  name        = jira_priority.foo.name
+  project_key = jira_project.foo.key
+}
+
+data "jira_priorities" "project" {
+  project_key = jira_project.foo.key
+}
+
+data "jira_priorities" "all" {
+  depends_on = [jira_priority.foo, jira_priority.bar]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jira_priority.by_name", "id", "jira_priority.bar", "id"),
+					resource.TestCheckResourceAttr("data.jira_priority.by_name", "color", "#00ff00"),
+					resource.TestCheckResourceAttr("data.jira_priority.by_name", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttrPair("data.jira_priority.by_name", "icon_url", "jira_priority.bar", "icon_url"),
+					resource.TestCheckResourceAttrPair("data.jira_priority.in_project", "id", "jira_priority.foo", "id"),
+					resource.TestCheckResourceAttr("data.jira_priorities.project", "priorities.#", "1"),
+					resource.TestCheckResourceAttrPair("data.jira_priorities.project", "priorities.0.id", "jira_priority.foo", "id"),
+					resource.TestCheckResourceAttr("data.jira_priorities.all", "priorities.0.name", "Highest"),
+				),
+			},
+			{
+				// bar is not part of the priority scheme of the project
+				Config: testAccJiraPriorityDataSourceConfig(rInt, `
+data "jira_priority" "in_project" {
+  name        = jira_priority.bar.name
+  project_key = jira_project.foo.key
+}
+`),
+				ExpectError: regexp.MustCompile(`no jira priority with name "bar-priority-\d+" found in project PX\d+`),
+			},
+		},
+	})
+}
+
+func testAccJiraPriorityDataSourceConfig(rInt int, dataSources string) string {
+	return fmt.Sprintf(`
+resource "jira_priority" "foo" {
+  name  = "foo-priority-%d"
+  color = "#ff0000"
+}
+
+resource "jira_priority" "bar" {
+  name        = "bar-priority-%d"
+  description = "Created by Terraform"
+  color       = "#00ff00"
+}
+
+resource "jira_priority_scheme" "foo" {
+  name         = "foo-scheme-%d"
+  priority_ids = [jira_priority.foo.id]
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  priority_scheme      = jira_priority_scheme.foo.id
+}
+%s
+`, rInt, rInt, rInt, rInt, rInt, rInt%100000, dataSources)
+}
@@ -0,0 +1,239 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceProject is used to look up an existing JIRA project
+func dataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"key", "project_id"},
+				Description:  "Key of the project to look up",
+			},
+			"project_id": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"key", "project_id"},
+				Description:  "ID of the project to look up",
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lead": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lead_account_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assignee_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_type_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"archived": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"issue_security_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"permission_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"notification_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issue_type_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issue_type_screen_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"field_configuration_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"priority_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"workflow_scheme": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"issue_types": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Issue types available in the project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_subtask": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"components": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Components of the project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Versions of the project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"released": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"archived": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"release_date": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceProjectRead reads project details using jira api
+func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	idOrKey := d.Get("key").(string)
+	if id, ok := d.GetOk("project_id"); ok {
+		idOrKey = strconv.Itoa(id.(int))
+	}
+
+	project := &Project{}
+	urlStr := fmt.Sprintf("%s/%s", projectAPIEndpoint, idOrKey)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, project)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira project failed", nil)
+	}
+
+	d.SetId(project.ID)
+	if diags := setProjectResource(ctx, config.jiraClient, project, d); diags.HasError() {
+		return diags
+	}
+
+	issueTypes := make([]map[string]interface{}, 0, len(project.IssueTypes))
+	for _, issueType := range project.IssueTypes {
+		issueTypes = append(issueTypes, map[string]interface{}{
+			"id":          issueType.ID,
+			"name":        issueType.Name,
+			"description": issueType.Description,
+			"is_subtask":  issueType.Subtask,
+		})
+	}
+	d.Set("issue_types", issueTypes)
+
+	components := make([]map[string]interface{}, 0, len(project.Components))
+	for _, component := range project.Components {
+		components = append(components, map[string]interface{}{
+			"id":          component.ID,
+			"name":        component.Name,
+			"description": component.Description,
+		})
+	}
+	d.Set("components", components)
+
+	versions := make([]map[string]interface{}, 0, len(project.Versions))
+	for _, version := range project.Versions {
+		versions = append(versions, map[string]interface{}{
+			"id":           version.ID,
+			"name":         version.Name,
+			"description":  version.Description,
+			"released":     version.Released != nil && *version.Released,
+			"archived":     version.Archived != nil && *version.Archived,
+			"release_date": version.ReleaseDate,
+		})
+	}
+	d.Set("versions", versions)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraProjectDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jira_project.by_key", "id", "jira_project.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.jira_project.by_key", "project_id", "jira_project.foo", "project_id"),
+					resource.TestCheckResourceAttrPair("data.jira_project.by_key", "name", "jira_project.foo", "name"),
+					resource.TestCheckResourceAttrPair("data.jira_project.by_key", "lead", "jira_project.foo", "lead"),
+					resource.TestCheckResourceAttrPair("data.jira_project.by_key", "category_id", "jira_project.foo", "category_id"),
+					resource.TestCheckResourceAttr("data.jira_project.by_key", "project_type_key", "business"),
+					resource.TestCheckResourceAttr("data.jira_project.by_key", "components.#", "1"),
+					resource.TestCheckResourceAttrPair("data.jira_project.by_key", "components.0.id", "jira_component.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.jira_project.by_key", "issue_types.0.name"),
+					resource.TestCheckResourceAttrPair("data.jira_project.by_id", "key", "jira_project.foo", "key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraProjectDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project_category" "category" {
+  name        = "Managed %d"
+  description = "Managed Projects"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = "${jira_user.foo.name}"
+  project_type_key     = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+  category_id          = "${jira_project_category.category.id}"
+}
+
+resource "jira_component" "foo" {
+  name        = "foo-component-%d"
+  project_key = "${jira_project.foo.key}"
+}
+
+data "jira_project" "by_key" {
+  key = "${jira_component.foo.project_key}"
+}
+
+data "jira_project" "by_id" {
+  project_id = "${jira_project.foo.project_id}"
+}
+`, rInt, rInt, rInt, rInt%100000, rInt)
+}
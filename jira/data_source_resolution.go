@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"context"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resolutionAttributes are the attributes of a resolution returned by the resolution data sources
+func resolutionAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// dataSourceResolution is used to look up a JIRA resolution by name.
+// Resolutions are shared by all projects.
+func dataSourceResolution() *schema.Resource {
+	attributes := resolutionAttributes()
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the resolution to look up",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceResolutionRead,
+		Schema:      attributes,
+	}
+}
+
+// dataSourceResolutions is used to list the JIRA resolutions
+func dataSourceResolutions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResolutionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resolutions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: resolutionAttributes()},
+			},
+		},
+	}
+}
+
+func listResolutions(ctx context.Context, client *jira.Client) ([]jira.Resolution, error) {
+	resolutions := []jira.Resolution{}
+	err := request(ctx, client, "GET", resolutionAPIEndpoint, nil, &resolutions)
+	return resolutions, err
+}
+
+func flattenResolution(resolution jira.Resolution) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          resolution.ID,
+		"name":        resolution.Name,
+		"description": resolution.Description,
+	}
+}
+
+// dataSourceResolutionRead reads resolution details using jira api
+func dataSourceResolutionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	name := d.Get("name").(string)
+
+	resolutions, err := listResolutions(ctx, config.jiraClient)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira resolutions failed", nil)
+	}
+
+	for _, resolution := range resolutions {
+		if strings.EqualFold(resolution.Name, name) {
+			d.SetId(resolution.ID)
+			for k, v := range flattenResolution(resolution) {
+				d.Set(k, v)
+			}
+			return nil
+		}
+	}
+
+	return diag.Errorf("no jira resolution with name %q found", name)
+}
+
+// dataSourceResolutionsRead lists resolutions using jira api
+func dataSourceResolutionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	resolutions, err := listResolutions(ctx, config.jiraClient)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira resolutions failed", nil)
+	}
+
+	values := make([]map[string]interface{}, 0, len(resolutions))
+	for _, resolution := range resolutions {
+		values = append(values, flattenResolution(resolution))
+	}
+
+	d.SetId(listID(""))
+	d.Set("resolutions", values)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraResolutionDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraResolutionDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jira_resolution.by_name", "id", "jira_resolution.foo", "id"),
+					resource.TestCheckResourceAttr("data.jira_resolution.by_name", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("data.jira_resolution.done", "name", "Done"),
+					resource.TestCheckResourceAttrSet("data.jira_resolution.done", "id"),
+					resource.TestCheckResourceAttrSet("data.jira_resolutions.all", "resolutions.0.name"),
+				),
+			},
+			{
+				Config: `
+data "jira_resolution" "missing" {
+  name = "Never Resolved"
+}
+`,
+				ExpectError: regexp.MustCompile(`no jira resolution with name "Never Resolved" found`),
+			},
+		},
+	})
+}
+
+func testAccJiraResolutionDataSourceConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_resolution" "foo" {
+  name        = "foo-resolution-%d"
+  description = "Created by Terraform"
+}
+
+data "jira_resolution" "by_name" {
+  name = jira_resolution.foo.name
+}
+
+data "jira_resolution" "done" {
+  name = "done"
+}
+
+data "jira_resolutions" "all" {
+  depends_on = [jira_resolution.foo]
+}
+`, rInt)
+}
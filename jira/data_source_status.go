@@ -0,0 +1,184 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// statusCategoryKeys maps the keys of status categories returned by the issue
+// endpoints to the categories used by jira_status
+var statusCategoryKeys = map[string]string{
+	"new":           "TODO",
+	"indeterminate": "IN_PROGRESS",
+	"done":          "DONE",
+}
+
+// statusAttributes are the attributes of a status returned by the status data sources
+func statusAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status_category": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Category of the status, one of TODO, IN_PROGRESS or DONE",
+		},
+	}
+}
+
+// dataSourceStatus is used to look up a JIRA status by name
+func dataSourceStatus() *schema.Resource {
+	attributes := statusAttributes()
+	attributes["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the status to look up",
+	}
+	attributes["project_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Key of a project. If set, only statuses used by the workflows of the project are considered",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceStatusRead,
+		Schema:      attributes,
+	}
+}
+
+// dataSourceStatuses is used to list the JIRA statuses
+func dataSourceStatuses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStatusesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of a project. If set, only statuses used by the workflows of the project are listed",
+			},
+			"statuses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: statusAttributes()},
+			},
+		},
+	}
+}
+
+// listStatuses returns all statuses, or the statuses used by the workflows of
+// the project if projectKey is set
+func listStatuses(ctx context.Context, client *jira.Client, projectKey string) ([]jira.Status, error) {
+	if projectKey == "" {
+		statuses := []jira.Status{}
+		err := request(ctx, client, "GET", statusAPIEndpoint, nil, &statuses)
+		return statuses, err
+	}
+
+	// The statuses are returned for each issue type of the project
+	issueTypes := []struct {
+		Statuses []jira.Status `json:"statuses"`
+	}{}
+	err := request(ctx, client, "GET", fmt.Sprintf("%s/%s/statuses", projectAPIEndpoint, projectKey), nil, &issueTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []jira.Status{}
+	seen := make(map[string]bool)
+	for _, issueType := range issueTypes {
+		for _, status := range issueType.Statuses {
+			if !seen[status.ID] {
+				seen[status.ID] = true
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func flattenStatus(status jira.Status) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              status.ID,
+		"name":            status.Name,
+		"description":     status.Description,
+		"status_category": statusCategoryKeys[status.StatusCategory.Key],
+	}
+}
+
+// inProject describes the project scope of a lookup in error messages
+func inProject(projectKey string) string {
+	if projectKey == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in project %s", projectKey)
+}
+
+// dataSourceStatusRead reads status details using jira api
+func dataSourceStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	name := d.Get("name").(string)
+	projectKey := d.Get("project_key").(string)
+
+	statuses, err := listStatuses(ctx, config.jiraClient, projectKey)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira statuses failed", nil)
+	}
+
+	for _, status := range statuses {
+		if strings.EqualFold(status.Name, name) {
+			d.SetId(status.ID)
+			for k, v := range flattenStatus(status) {
+				d.Set(k, v)
+			}
+			return nil
+		}
+	}
+
+	return diag.Errorf("no jira status with name %q found%s", name, inProject(projectKey))
+}
+
+// dataSourceStatusesRead lists statuses using jira api
+func dataSourceStatusesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	projectKey := d.Get("project_key").(string)
+
+	statuses, err := listStatuses(ctx, config.jiraClient, projectKey)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira statuses failed", nil)
+	}
+
+	values := make([]map[string]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, flattenStatus(status))
+	}
+
+	d.SetId(listID(projectKey))
+	d.Set("statuses", values)
+
+	return nil
+}
+
+// listID returns the ID of a data source listing the entities of the project,
+// or of all projects
+func listID(projectKey string) string {
+	if projectKey == "" {
+		return "all"
+	}
+	return projectKey
+}
@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraStatusDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraStatusDataSourceConfig(rInt, `
+data "jira_status" "by_name" {
+  name = jira_status.foo.name
+}
+
+data "jira_status" "in_project" {
+  name        = "open"
+  project_key = jira_project.foo.key
+}
+
+data "jira_statuses" "project" {
+  project_key = jira_project.foo.key
+}
+
+data "jira_statuses" "all" {
+  depends_on = [jira_status.foo]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jira_status.by_name", "id", "jira_status.foo", "id"),
+					resource.TestCheckResourceAttr("data.jira_status.by_name", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr("data.jira_status.by_name", "status_category", "IN_PROGRESS"),
+					resource.TestCheckResourceAttr("data.jira_status.in_project", "id", "1"),
+					resource.TestCheckResourceAttr("data.jira_status.in_project", "name", "Open"),
+					resource.TestCheckResourceAttr("data.jira_status.in_project", "status_category", "TODO"),
+					resource.TestCheckResourceAttr("data.jira_statuses.project", "statuses.#", "2"),
+					resource.TestCheckResourceAttr("data.jira_statuses.project", "statuses.0.id", "1"),
+					resource.TestCheckResourceAttrPair("data.jira_statuses.project", "statuses.1.id", "jira_status.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.jira_statuses.all", "statuses.0.name"),
+				),
+			},
+			{
+				// Done is not part of the workflow of the project
+				Config: testAccJiraStatusDataSourceConfig(rInt, `
+data "jira_status" "in_project" {
+  name        = "Done"
+  project_key = jira_project.foo.key
+}
+`),
+				ExpectError: regexp.MustCompile(`no jira status with name "Done" found in project PX\d+`),
+			},
+		},
+	})
+}
+
+func testAccJiraStatusDataSourceConfig(rInt int, dataSources string) string {
+	return fmt.Sprintf(`
+resource "jira_status" "foo" {
+  name        = "review-status-%d"
+  description = "Created by Terraform"
+  category    = "IN_PROGRESS"
+}
+
+resource "jira_workflow" "foo" {
+  name = "foo-workflow-%d"
+
+  status {
+    status_id = "1"
+  }
+
+  status {
+    status_id = jira_status.foo.id
+  }
+
+  transition {
+    name = "Create"
+    type = "initial"
+    to   = "1"
+  }
+
+  transition {
+    name = "Review"
+    type = "global"
+    to   = jira_status.foo.id
+  }
+}
+
+resource "jira_workflow_scheme" "foo" {
+  name             = "foo-scheme-%d"
+  default_workflow = jira_workflow.foo.name
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  workflow_scheme      = jira_workflow_scheme.foo.id
+}
+%s
+`, rInt, rInt, rInt, rInt, rInt, rInt%100000, dataSources)
+}
@@ -0,0 +1,205 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// userWithGroups is a user including the groups it belongs to
+type userWithGroups struct {
+	jira.User
+	Groups Groups `json:"groups,omitempty" structs:"groups,omitempty"`
+}
+
+var userLookupAttributes = []string{"name", "key", "email", "account_id"}
+
+// dataSourceUser is used to look up an existing JIRA user
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: userLookupAttributes,
+				Description:  "Username of the user to look up",
+			},
+			"key": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: userLookupAttributes,
+				Description:  "Key of the user to look up",
+			},
+			"email": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: userLookupAttributes,
+				Description: "Email address of the user to look up. The lookup fails if more than one user has this address. " +
+					"On JIRA Cloud, addresses hidden by the profile visibility of the users can only be looked up if a single user is found",
+			},
+			"account_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: userLookupAttributes,
+				Description:  "Account ID of the user to look up (JIRA Cloud only)",
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"active": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"groups": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the groups the user belongs to",
+			},
+		},
+	}
+}
+
+// getUserWithGroups fetches a single user identified by the query, e.g. by key or username
+func getUserWithGroups(ctx context.Context, client *jira.Client, query url.Values) (*userWithGroups, error) {
+	query.Set("expand", "groups")
+	apiEndpoint := fmt.Sprintf("%s?%s", userAPIEndpoint, query.Encode())
+
+	user := new(userWithGroups)
+	err := request(ctx, client, "GET", apiEndpoint, nil, user)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// findUsersByEmail returns all users with the given email address
+func findUsersByEmail(ctx context.Context, client *jira.Client, email string) ([]jira.User, error) {
+	query := url.Values{}
+	// JIRA Server searches by username, JIRA Cloud by query. Both match email addresses.
+	query.Set("username", email)
+	query.Set("query", email)
+	query.Set("includeInactive", "true")
+	query.Set("maxResults", "1000")
+	apiEndpoint := fmt.Sprintf("%s/search?%s", userAPIEndpoint, query.Encode())
+
+	var candidates []jira.User
+	err := request(ctx, client, "GET", apiEndpoint, nil, &candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	// The search also matches names and partial addresses
+	var users, hidden []jira.User
+	for _, user := range candidates {
+		if strings.EqualFold(user.EmailAddress, email) {
+			users = append(users, user)
+		} else if user.EmailAddress == "" {
+			hidden = append(hidden, user)
+		}
+	}
+
+	// JIRA Cloud hides the address of users depending on their profile
+	// visibility. A single user found with a hidden address is the one
+	// searched for.
+	if len(users) == 0 && len(hidden) == 1 {
+		return hidden, nil
+	}
+	if len(users) == 0 && len(hidden) > 1 {
+		return nil, fmt.Errorf("the email address of the %d jira users found isn't visible, use name, key or account_id to select one", len(hidden))
+	}
+	return users, nil
+}
+
+// dataSourceUserRead reads user details using jira api
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	query := url.Values{}
+	var lookup string
+
+	if name, ok := d.GetOk("name"); ok {
+		query.Set("username", name.(string))
+		lookup = fmt.Sprintf("name %q", name)
+	} else if key, ok := d.GetOk("key"); ok {
+		query.Set("key", key.(string))
+		lookup = fmt.Sprintf("key %q", key)
+	} else if accountID, ok := d.GetOk("account_id"); ok {
+		query.Set("accountId", accountID.(string))
+		lookup = fmt.Sprintf("account ID %q", accountID)
+	} else {
+		email := d.Get("email").(string)
+		lookup = fmt.Sprintf("email %q", email)
+
+		users, err := findUsersByEmail(ctx, config.jiraClient, email)
+		if err != nil {
+			return errorDiagnostics(err, "searching jira users failed", nil)
+		}
+
+		if len(users) == 0 {
+			return diag.Errorf("no jira user with %s found", lookup)
+		}
+
+		if len(users) > 1 {
+			names := make([]string, 0, len(users))
+			for _, user := range users {
+				if user.Name != "" {
+					names = append(names, user.Name)
+				} else {
+					names = append(names, user.AccountID)
+				}
+			}
+			sort.Strings(names)
+			return diag.Errorf("%d jira users with %s found (%s), use name, key or account_id to select one", len(users), lookup, strings.Join(names, ", "))
+		}
+
+		if users[0].AccountID != "" {
+			query.Set("accountId", users[0].AccountID)
+		} else {
+			query.Set("key", users[0].Key)
+		}
+	}
+
+	user, err := getUserWithGroups(ctx, config.jiraClient, query)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			return diag.Errorf("no jira user with %s found", lookup)
+		}
+		return errorDiagnostics(err, "reading jira user failed", nil)
+	}
+
+	id := user.Key
+	if id == "" {
+		id = user.AccountID
+	}
+	d.SetId(id)
+
+	groups := make([]string, 0, len(user.Groups.Items))
+	for _, group := range user.Groups.Items {
+		groups = append(groups, group.Name)
+	}
+
+	d.Set("name", user.Name)
+	d.Set("key", user.Key)
+	d.Set("email", user.EmailAddress)
+	d.Set("account_id", user.AccountID)
+	d.Set("display_name", user.DisplayName)
+	d.Set("active", user.Active)
+	d.Set("groups", groups)
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraUserDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraUserDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jira_user.by_name", "key", "jira_user.foo", "id"),
+					resource.TestCheckResourceAttr("data.jira_user.by_name", "display_name", "Foo User"),
+					resource.TestCheckResourceAttr("data.jira_user.by_name", "active", "true"),
+					resource.TestCheckResourceAttr("data.jira_user.by_name", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.jira_user.by_name", "groups.0", "jira_group.foo", "name"),
+					resource.TestCheckResourceAttrPair("data.jira_user.by_key", "name", "jira_user.foo", "name"),
+					resource.TestCheckResourceAttrPair("data.jira_user.by_email", "key", "jira_user.foo", "id"),
+				),
+			},
+			{
+				Config:      testAccJiraUserDataSourceConfigAmbiguous(rInt),
+				ExpectError: regexp.MustCompile(`2 jira users with email "shared-\d+@example.org" found`),
+			},
+		},
+	})
+}
+
+func TestFindUsersByEmail_hiddenAddress(t *testing.T) {
+	fake := newFakeJira()
+	defer fake.Close()
+	emails := map[string]string{"jane": "jane@example.org", "shared-1": "shared@example.org", "shared-2": "shared@example.org"}
+	for key, email := range emails {
+		fake.users[key] = fakeObject{"key": key, "accountId": key, "emailAddress": email, "active": true}
+		fake.hiddenEmails[key] = true
+	}
+
+	ctx := context.Background()
+	client := testFakeJiraClient(t, fake, "admin")
+	users, err := findUsersByEmail(ctx, client, "Jane@example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].AccountID != "jane" {
+		t.Fatalf("expected the user jane with the hidden address, got %v", users)
+	}
+
+	_, err = findUsersByEmail(ctx, client, "shared@example.org")
+	if err == nil || !regexp.MustCompile(`email address of the 2 jira users found isn't visible`).MatchString(err.Error()) {
+		t.Fatalf("expected an error about the hidden addresses, got %v", err)
+	}
+}
+
+func testAccJiraUserDataSourceUsers(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name         = "foo-user-%d"
+  email        = "foo-%d@example.org"
+  display_name = "Foo User"
+}
+
+resource "jira_user" "bar" {
+  name  = "bar-user-%d"
+  email = "shared-%d@example.org"
+}
+
+resource "jira_user" "baz" {
+  name  = "baz-user-%d"
+  email = "shared-%d@example.org"
+}
+
+resource "jira_group" "foo" {
+  name = "foo-group-%d"
+}
+
+resource "jira_group_membership" "foo" {
+  username = "${jira_user.foo.name}"
+  group    = "${jira_group.foo.name}"
+}
+`, rInt, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccJiraUserDataSourceConfig(rInt int) string {
+	return testAccJiraUserDataSourceUsers(rInt) + `
+data "jira_user" "by_name" {
+  name = "${jira_group_membership.foo.username}"
+}
+
+data "jira_user" "by_key" {
+  key = "${jira_user.foo.id}"
+}
+
+data "jira_user" "by_email" {
+  email = "${jira_user.foo.email}"
+}
+`
+}
+
+func testAccJiraUserDataSourceConfigAmbiguous(rInt int) string {
+	return testAccJiraUserDataSourceUsers(rInt) + `
+data "jira_user" "ambiguous" {
+  email      = "${jira_user.bar.email}"
+  depends_on = [jira_user.baz]
+}
+`
+}
@@ -0,0 +1,169 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/pkg/errors"
+)
+
+// APIError is returned when JIRA rejects a request. It carries the error
+// collection JIRA sends along with the response.
+// JIRA API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#error-responses
+type APIError struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+
+	// ErrorMessages contains errors which are not related to a specific field
+	ErrorMessages []string
+
+	// Errors maps the names of rejected fields to the reason
+	Errors map[string]string
+
+	// Body holds the raw response if JIRA didn't send an error collection
+	Body string
+
+	wrapped error
+}
+
+func (e *APIError) Error() string {
+	messages := append([]string{}, e.ErrorMessages...)
+
+	for _, field := range e.fields() {
+		messages = append(messages, fmt.Sprintf("%s: %s", field, e.Errors[field]))
+	}
+
+	if len(messages) == 0 && e.Body != "" {
+		messages = append(messages, e.Body)
+	}
+
+	if len(messages) == 0 {
+		return fmt.Sprintf("%s %s failed with status %d", e.Method, e.Endpoint, e.StatusCode)
+	}
+
+	return fmt.Sprintf("%s %s failed with status %d: %s", e.Method, e.Endpoint, e.StatusCode, strings.Join(messages, "; "))
+}
+
+func (e *APIError) Unwrap() error {
+	return e.wrapped
+}
+
+// Is allows a 404 APIError to match ResourceNotFoundError
+func (e *APIError) Is(target error) bool {
+	return target == ResourceNotFoundError && e.StatusCode == 404
+}
+
+// fields returns the names of the rejected fields in a stable order
+func (e *APIError) fields() []string {
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// newAPIError converts the result of jira.Client.Do into an error. It is safe
+// to call with a nil response, which happens if the request never reached JIRA.
+func newAPIError(method string, endpoint string, res *jira.Response, err error) error {
+	if res == nil || res.Response == nil {
+		return errors.Wrapf(err, "%s %s failed", method, endpoint)
+	}
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		// The request succeeded, but the response couldn't be decoded
+		return errors.Wrapf(err, "decoding response of %s %s failed", method, endpoint)
+	}
+
+	apiErr := &APIError{
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: res.StatusCode,
+		wrapped:    err,
+	}
+
+	// Some go-jira services already consumed the body and decoded the error collection
+	var jiraErr *jira.Error
+	if errors.As(err, &jiraErr) {
+		apiErr.ErrorMessages = jiraErr.ErrorMessages
+		apiErr.Errors = jiraErr.Errors
+		return apiErr
+	}
+
+	if res.Body == nil {
+		return apiErr
+	}
+
+	defer res.Body.Close()
+	body, readErr := ioutil.ReadAll(res.Body)
+	if readErr != nil {
+		return apiErr
+	}
+
+	collection := struct {
+		ErrorMessages []string               `json:"errorMessages"`
+		Errors        map[string]interface{} `json:"errors"`
+	}{}
+
+	if json.Unmarshal(body, &collection) != nil ||
+		(len(collection.ErrorMessages) == 0 && len(collection.Errors) == 0) {
+		apiErr.Body = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	apiErr.ErrorMessages = collection.ErrorMessages
+	apiErr.Errors = make(map[string]string, len(collection.Errors))
+	for field, message := range collection.Errors {
+		apiErr.Errors[field] = fmt.Sprintf("%v", message)
+	}
+
+	return apiErr
+}
+
+// errorDiagnostics converts err into diagnostics. Each field rejected by JIRA
+// becomes a separate diagnostic, attached to the attribute returned by
+// attributePath. attributePath may be nil or return nil for unknown fields.
+func errorDiagnostics(err error, summary string, attributePath func(field string) cty.Path) diag.Diagnostics {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || (len(apiErr.ErrorMessages) == 0 && len(apiErr.Errors) == 0) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		}}
+	}
+
+	var diags diag.Diagnostics
+
+	for _, message := range apiErr.ErrorMessages {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   message,
+		})
+	}
+
+	for _, field := range apiErr.fields() {
+		d := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("%s: %s", field, apiErr.Errors[field]),
+		}
+		if attributePath != nil {
+			if path := attributePath(field); path != nil {
+				d.Detail = apiErr.Errors[field]
+				d.AttributePath = path
+			}
+		}
+		diags = append(diags, d)
+	}
+
+	return diags
+}
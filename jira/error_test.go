@@ -0,0 +1,86 @@
+package jira
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func testJiraResponse(status int, body string) *jira.Response {
+	return &jira.Response{Response: &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}}
+}
+
+func TestNewAPIError_decodesErrorCollection(t *testing.T) {
+	res := testJiraResponse(400, `{"errorMessages":["Project is archived"],"errors":{"summary":"You must specify a summary of the issue.","customfield_10010":"Epic does not exist"}}`)
+
+	err := newAPIError("POST", issueAPIEndpoint, res, errors.New("request failed"))
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != 400 || apiErr.Endpoint != issueAPIEndpoint {
+		t.Errorf("unexpected status %d or endpoint %s", apiErr.StatusCode, apiErr.Endpoint)
+	}
+
+	expected := "POST /rest/api/2/issue failed with status 400: Project is archived; customfield_10010: Epic does not exist; summary: You must specify a summary of the issue."
+	if err.Error() != expected {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+
+	diags := errorDiagnostics(err, "creating jira issue failed", issueAttributePath)
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %d", len(diags))
+	}
+
+	if diags[0].AttributePath != nil {
+		t.Errorf("expected no attribute path for a general error message, got %#v", diags[0].AttributePath)
+	}
+
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("fields").IndexString("customfield_10010")) {
+		t.Errorf("unexpected attribute path %#v", diags[1].AttributePath)
+	}
+
+	if !diags[2].AttributePath.Equals(cty.GetAttrPath("summary")) {
+		t.Errorf("unexpected attribute path %#v", diags[2].AttributePath)
+	}
+}
+
+func TestNewAPIError_notFound(t *testing.T) {
+	err := newAPIError("GET", projectAPIEndpoint+"/PX", testJiraResponse(404, `<html>Not Found</html>`), errors.New("request failed"))
+
+	if !errors.Is(err, ResourceNotFoundError) {
+		t.Errorf("expected %q to match ResourceNotFoundError", err)
+	}
+
+	if !strings.HasSuffix(err.Error(), "<html>Not Found</html>") {
+		t.Errorf("expected the raw body in %q", err)
+	}
+}
+
+func TestNewAPIError_withoutResponse(t *testing.T) {
+	err := newAPIError("GET", projectAPIEndpoint, nil, errors.New("connection refused"))
+
+	if err.Error() != "GET /rest/api/2/project failed: connection refused" {
+		t.Errorf("unexpected message %q", err)
+	}
+
+	if errors.Is(err, ResourceNotFoundError) {
+		t.Errorf("a network failure must not be reported as missing resource")
+	}
+
+	diags := errorDiagnostics(err, "getting jira project failed", nil)
+	if len(diags) != 1 || diags[0].Detail != err.Error() {
+		t.Errorf("unexpected diagnostics %#v", diags)
+	}
+}
@@ -0,0 +1,5187 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeJira is an in-memory implementation of the parts of the Jira REST API
+// used by the provider. It allows running the acceptance tests without a
+// Jira instance, see TestMain.
+type fakeJira struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	lastID int
+	routes []fakeRoute
+
+	users               fakeCollection
+	groups              fakeCollection
+	projects            fakeCollection
+	projectCategories   fakeCollection
+	projectRoleActors   map[string][]fakeObject
+	components          fakeCollection
+	roles               fakeCollection
+	issues              fakeCollection
+	issueCounters       map[string]int
+	issueTypes          fakeCollection
+	issueLinks          fakeCollection
+	issueLinkTypes      fakeCollection
+	attachments         fakeCollection
+	issueWatchers       map[string][]string
+	statuses            fakeCollection
+	filters             fakeCollection
+	webhooks            fakeCollection
+	versions            fakeCollection
+	fields              fakeCollection
+	fieldContexts       fakeCollection
+	fieldOptions        map[string][]fakeObject
+	fieldDefaults       map[string]fakeObject
+	permissionSchemes   fakeCollection
+	notificationSchemes fakeCollection
+	securitySchemes     fakeCollection
+	securityMembers     map[string][]fakeObject
+	workflows           fakeCollection
+	workflowSchemes     fakeCollection
+	workflowDrafts      fakeCollection
+	tasks               fakeCollection
+	issueTypeSchemes    fakeCollection
+	// issueTypeSchemeIssueTypes holds the ordered issue types of the schemes
+	issueTypeSchemeIssueTypes map[string][]string
+	screens                   fakeCollection
+	screenTabs                map[string][]fakeObject
+	screenTabFields           map[string][]fakeObject
+	screenSchemes             fakeCollection
+	issueTypeScreenSchemes    fakeCollection
+	// issueTypeScreenSchemeMappings maps the issue types of the schemes to
+	// screen schemes, the issue type default applies to all others
+	issueTypeScreenSchemeMappings map[string]map[string]string
+	fieldConfigurations           fakeCollection
+	// fieldConfigurationItems holds the configured fields of the field
+	// configurations, fields without item use the defaults
+	fieldConfigurationItems   map[string]map[string]fakeObject
+	fieldConfigurationSchemes fakeCollection
+	// fieldConfigurationSchemeMappings maps the issue types of the schemes to
+	// field configurations, the issue type default applies to all others
+	fieldConfigurationSchemeMappings map[string]map[string]string
+	priorities                       fakeCollection
+	prioritySchemes                  fakeCollection
+	resolutions                      fakeCollection
+	// hiddenEmails holds the keys of the users whose email address is hidden
+	// by their profile visibility, as on Jira Cloud
+	hiddenEmails map[string]bool
+}
+
+// fakeObject is the JSON representation of a Jira entity
+type fakeObject map[string]interface{}
+
+// fakeCollection holds the entities of one type by ID
+type fakeCollection map[string]fakeObject
+
+// fakeHandler handles a request matched by a route. params contains the
+// submatches of the route pattern. The returned object is encoded as JSON.
+type fakeHandler func(w http.ResponseWriter, r *http.Request, params []string) (int, interface{})
+
+type fakeRoute struct {
+	method  string
+	pattern *regexp.Regexp
+	handler fakeHandler
+}
+
+// fakeCollectionOptions customizes the generic CRUD endpoints of a collection
+type fakeCollectionOptions struct {
+	// numericIDs encodes the id attribute as number instead of a string
+	numericIDs bool
+
+	// prepare adapts a request body to the representation returned by Jira.
+	// obj is the object being updated, or nil if it is created. Returning a
+	// non-nil object rejects the request with status 400.
+	prepare func(obj, body fakeObject) fakeObject
+}
+
+func newFakeJira() *fakeJira {
+	f := &fakeJira{
+		lastID: 10000,
+
+		users:                            fakeCollection{},
+		hiddenEmails:                     map[string]bool{},
+		groups:                           fakeCollection{},
+		projects:                         fakeCollection{},
+		projectCategories:                fakeCollection{},
+		projectRoleActors:                map[string][]fakeObject{},
+		components:                       fakeCollection{},
+		roles:                            fakeCollection{},
+		issues:                           fakeCollection{},
+		issueCounters:                    map[string]int{},
+		issueTypes:                       fakeCollection{},
+		issueLinks:                       fakeCollection{},
+		issueLinkTypes:                   fakeCollection{},
+		attachments:                      fakeCollection{},
+		issueWatchers:                    map[string][]string{},
+		statuses:                         fakeCollection{},
+		filters:                          fakeCollection{},
+		webhooks:                         fakeCollection{},
+		versions:                         fakeCollection{},
+		fields:                           fakeCollection{},
+		fieldContexts:                    fakeCollection{},
+		fieldOptions:                     map[string][]fakeObject{},
+		fieldDefaults:                    map[string]fakeObject{},
+		permissionSchemes:                fakeCollection{},
+		notificationSchemes:              fakeCollection{},
+		securitySchemes:                  fakeCollection{},
+		securityMembers:                  map[string][]fakeObject{},
+		workflows:                        fakeCollection{},
+		workflowSchemes:                  fakeCollection{},
+		workflowDrafts:                   fakeCollection{},
+		tasks:                            fakeCollection{},
+		issueTypeSchemes:                 fakeCollection{},
+		issueTypeSchemeIssueTypes:        map[string][]string{},
+		screens:                          fakeCollection{},
+		screenTabs:                       map[string][]fakeObject{},
+		screenTabFields:                  map[string][]fakeObject{},
+		screenSchemes:                    fakeCollection{},
+		issueTypeScreenSchemes:           fakeCollection{},
+		issueTypeScreenSchemeMappings:    map[string]map[string]string{},
+		fieldConfigurations:              fakeCollection{},
+		fieldConfigurationItems:          map[string]map[string]fakeObject{},
+		fieldConfigurationSchemes:        fakeCollection{},
+		fieldConfigurationSchemeMappings: map[string]map[string]string{},
+		priorities:                       fakeCollection{},
+		prioritySchemes:                  fakeCollection{},
+		resolutions:                      fakeCollection{},
+	}
+	f.Server = httptest.NewServer(f)
+
+	f.seed()
+	f.registerRoutes()
+
+	return f
+}
+
+// seed creates the entities available on a fresh Jira instance
+func (f *fakeJira) seed() {
+	f.users["admin"] = fakeObject{
+		"key":          "admin",
+		"name":         "admin",
+		"emailAddress": "admin@example.org",
+		"displayName":  "Administrator",
+		"active":       true,
+	}
+
+	for _, name := range []string{"Task", "Bug", "Story", "Epic"} {
+		f.insert(issueTypeAPIEndpoint, f.issueTypes, false, fakeObject{"name": name, "subtask": false})
+	}
+	f.insert(issueTypeAPIEndpoint, f.issueTypes, false, fakeObject{"name": "Sub-task", "subtask": true})
+	f.insert(issueTypeSchemeAPIEndpoint, f.issueTypeSchemes, false, fakeObject{
+		"name":        "Default Issue Type Scheme",
+		"description": "Default issue type scheme is the list of global issue types.",
+		"isDefault":   true,
+	})
+
+	// The statuses of the system workflow have fixed IDs
+	for id, name := range map[string]string{"1": "Open", "3": "In Progress", "10000": "Done"} {
+		f.statuses[id] = fakeObject{"id": id, "name": name, "description": "", "self": fmt.Sprintf("%s/rest/api/2/status/%s", f.URL, id)}
+	}
+	f.statuses["1"]["statusCategory"] = fakeStatusCategory("TODO")
+	f.statuses["3"]["statusCategory"] = fakeStatusCategory("IN_PROGRESS")
+	f.statuses["10000"]["statusCategory"] = fakeStatusCategory("DONE")
+	open, inProgress, done := f.statuses["1"], f.statuses["3"], f.statuses["10000"]
+
+	for id, schemaType := range map[string]string{
+		"summary":     "string",
+		"description": "string",
+		"labels":      "array",
+		"assignee":    "user",
+		"reporter":    "user",
+		"fixVersions": "array",
+		"duedate":     "date",
+	} {
+		f.fields[id] = fakeObject{
+			"id":          id,
+			"key":         id,
+			"name":        strings.ToUpper(id[:1]) + id[1:],
+			"custom":      false,
+			"navigable":   true,
+			"searchable":  true,
+			"clauseNames": []string{id},
+			"schema":      fakeObject{"type": schemaType, "system": id},
+		}
+	}
+
+	transitions := []fakeObject{
+		{"id": "11", "name": "Start Progress", "to": inProgress},
+		{"id": "21", "name": "Done", "to": done},
+		{"id": "31", "name": "Reopen", "to": open},
+	}
+
+	systemWorkflow := fakeObject{
+		"id":          fakeObject{"name": "jira", "entityId": "jira"},
+		"description": "The default Jira workflow.",
+		"statuses":    []interface{}{fakeObject{"id": "1"}, fakeObject{"id": "3"}, fakeObject{"id": "10000"}},
+		"transitions": []interface{}{
+			fakeObject{"id": "1", "name": "Create", "type": "initial", "from": []interface{}{}, "to": "1"},
+		},
+	}
+	for _, transition := range transitions {
+		systemWorkflow["transitions"] = append(systemWorkflow["transitions"].([]interface{}), fakeObject{
+			"id": transition["id"], "name": transition["name"], "type": "global", "from": []interface{}{}, "to": reference(transition["to"])["id"],
+		})
+	}
+	f.workflows["jira"] = systemWorkflow
+
+	defaultScreen := f.insert(screenAPIEndpoint, f.screens, true, fakeObject{"name": "Default Screen", "description": "Allows to update all system fields."})
+	f.screenTabs[fmt.Sprintf("%v", defaultScreen["id"])] = []fakeObject{{"id": f.nextID(), "name": "Field Tab"}}
+	defaultScreenScheme := f.insert(screenSchemeAPIEndpoint, f.screenSchemes, true, fakeObject{
+		"name":        "Default Screen Scheme",
+		"description": "Default Screen Scheme",
+		"screens":     fakeObject{"default": defaultScreen["id"]},
+	})
+	defaultIssueTypeScreenScheme := f.insert(issueTypeScreenSchemeAPIEndpoint, f.issueTypeScreenSchemes, false, fakeObject{
+		"name":        "Default Issue Type Screen Scheme",
+		"description": "The default issue type screen scheme",
+		"isDefault":   true,
+	})
+	f.issueTypeScreenSchemeMappings[defaultIssueTypeScreenScheme["id"].(string)] = map[string]string{
+		"default": fmt.Sprintf("%v", defaultScreenScheme["id"]),
+	}
+
+	f.insert(fieldConfigurationAPIEndpoint, f.fieldConfigurations, true, fakeObject{
+		"name":        "Default Field Configuration",
+		"description": "The default field configuration",
+		"isDefault":   true,
+	})
+
+	// The priorities of a fresh instance have fixed IDs
+	priorityIDs := []string{}
+	for i, name := range []string{"Highest", "High", "Medium", "Low", "Lowest"} {
+		id := strconv.Itoa(i + 1)
+		f.priorities[id] = fakeObject{
+			"id":          id,
+			"name":        name,
+			"description": "",
+			"statusColor": "#cccccc",
+			"iconUrl":     fmt.Sprintf("%s/images/icons/priorities/%s.svg", f.URL, strings.ToLower(name)),
+			"self":        fmt.Sprintf("%s%s/%s", f.URL, priorityAPIEndpoint, id),
+		}
+		priorityIDs = append(priorityIDs, id)
+	}
+	f.insert(prioritySchemeAPIEndpoint, f.prioritySchemes, true, fakeObject{
+		"name":            "Default priority scheme",
+		"description":     "This is default priority scheme used by all projects without any other scheme assigned.",
+		"defaultOptionId": "3",
+		"optionIds":       priorityIDs,
+		"defaultScheme":   true,
+	})
+
+	for _, name := range []string{"Done", "Won't Do", "Duplicate"} {
+		f.insert(resolutionAPIEndpoint, f.resolutions, false, fakeObject{"name": name, "description": ""})
+	}
+}
+
+func (f *fakeJira) registerRoutes() {
+	f.crud(componentAPIEndpoint, f.components, fakeCollectionOptions{prepare: f.prepareComponent})
+	f.crud(filterAPIEndpoint, f.filters, fakeCollectionOptions{prepare: prepareFilter})
+	f.crud(issueLinkTypeAPIEndpoint, f.issueLinkTypes, fakeCollectionOptions{})
+	f.crud(issueTypeAPIEndpoint, f.issueTypes, fakeCollectionOptions{prepare: prepareIssueType})
+	f.crud(projectCategoryAPIEndpoint, f.projectCategories, fakeCollectionOptions{})
+	f.crud(roleAPIEndpoint, f.roles, fakeCollectionOptions{numericIDs: true})
+	f.crud(webhookAPIEndpoint, f.webhooks, fakeCollectionOptions{numericIDs: true})
+	f.crud(versionAPIEndpoint, f.versions, fakeCollectionOptions{prepare: f.prepareVersion})
+	f.crud(permissionSchemeAPIEndpoint, f.permissionSchemes, fakeCollectionOptions{numericIDs: true, prepare: f.preparePermissionScheme})
+	f.crud(notificationSchemeAPIEndpoint, f.notificationSchemes, fakeCollectionOptions{numericIDs: true, prepare: f.prepareNotificationScheme})
+	// Jira returns the ID of a new issue security scheme as string
+	f.handle("POST", issueSecuritySchemeAPIEndpoint, f.createSecurityScheme)
+	f.crud(issueSecuritySchemeAPIEndpoint, f.securitySchemes, fakeCollectionOptions{numericIDs: true, prepare: prepareSecurityScheme})
+	// Workflow schemes used by projects are changed using drafts
+	f.handle("PUT", workflowSchemeAPIEndpoint+`/(\d+)`, f.updateWorkflowScheme)
+	f.handle("DELETE", workflowSchemeAPIEndpoint+`/(\d+)`, f.deleteWorkflowScheme)
+	f.crud(workflowSchemeAPIEndpoint, f.workflowSchemes, fakeCollectionOptions{numericIDs: true, prepare: f.prepareWorkflowScheme})
+
+	// Deleting priorities and resolutions moves their issues in a task
+	f.handle("GET", priorityAPIEndpoint, f.getPriorities)
+	f.handle("DELETE", priorityAPIEndpoint+`/(\d+)`, f.deletePriority)
+	f.crud(priorityAPIEndpoint, f.priorities, fakeCollectionOptions{prepare: f.preparePriority})
+	f.handle("DELETE", prioritySchemeAPIEndpoint+`/(\d+)`, f.deletePriorityScheme)
+	f.crud(prioritySchemeAPIEndpoint, f.prioritySchemes, fakeCollectionOptions{numericIDs: true, prepare: f.preparePriorityScheme})
+	f.handle("GET", resolutionAPIEndpoint, f.getResolutions)
+	f.handle("DELETE", resolutionAPIEndpoint+`/(\d+)`, f.deleteResolution)
+	f.crud(resolutionAPIEndpoint, f.resolutions, fakeCollectionOptions{prepare: f.prepareResolution})
+	f.handle("GET", statusAPIEndpoint, f.getStatusList)
+	f.handle("POST", statusesAPIEndpoint, f.createStatuses)
+	f.handle("GET", statusesAPIEndpoint, f.getStatuses)
+	f.handle("PUT", statusesAPIEndpoint, f.updateStatuses)
+	f.handle("DELETE", statusesAPIEndpoint, f.deleteStatuses)
+
+	f.handle("POST", filterAPIEndpoint+`/(\d+)/permission`, f.addFilterPermission)
+	f.handle("DELETE", filterAPIEndpoint+`/(\d+)/permission/(\d+)`, f.deleteFilterPermission)
+	f.handle("POST", permissionSchemeAPIEndpoint+`/(\d+)/permission`, f.addPermissionGrant)
+	f.handle("DELETE", permissionSchemeAPIEndpoint+`/(\d+)/permission/(\d+)`, f.deletePermissionGrant)
+	f.handle("PUT", notificationSchemeAPIEndpoint+`/(\d+)/notification`, f.addNotifications)
+	f.handle("DELETE", notificationSchemeAPIEndpoint+`/(\d+)/notification/(\d+)`, f.deleteNotification)
+	f.handle("GET", issueSecuritySchemeAPIEndpoint+`/level/member`, f.getSecurityLevelMembers)
+	f.handle("PUT", issueSecuritySchemeAPIEndpoint+`/(\d+)/level`, f.addSecurityLevels)
+	f.handle("PUT", issueSecuritySchemeAPIEndpoint+`/(\d+)/level/default`, f.setSecurityLevelDefaults)
+	f.handle("PUT", issueSecuritySchemeAPIEndpoint+`/(\d+)/level/(\d+)`, f.updateSecurityLevel)
+	f.handle("DELETE", issueSecuritySchemeAPIEndpoint+`/(\d+)/level/(\d+)`, f.deleteSecurityLevel)
+	f.handle("PUT", issueSecuritySchemeAPIEndpoint+`/(\d+)/level/(\d+)/member`, f.addSecurityLevelMembers)
+	f.handle("DELETE", issueSecuritySchemeAPIEndpoint+`/(\d+)/level/(\d+)/member/(\d+)`, f.deleteSecurityLevelMember)
+
+	f.handle("POST", userAPIEndpoint, f.createUser)
+	f.handle("GET", userAPIEndpoint, f.getUser)
+	f.handle("DELETE", userAPIEndpoint, f.deleteUser)
+	f.handle("GET", userAPIEndpoint+"/search", f.searchUsers)
+
+	f.handle("POST", groupAPIEndpoint, f.createGroup)
+	f.handle("GET", groupAPIEndpoint+"/member", f.getGroupMembers)
+	f.handle("DELETE", groupAPIEndpoint, f.deleteGroup)
+	f.handle("POST", groupUserAPIEndpoint, f.addGroupMember)
+	f.handle("DELETE", groupUserAPIEndpoint, f.removeGroupMember)
+
+	f.handle("POST", projectAPIEndpoint, f.createProject)
+	f.handle("POST", `/rest/project-templates/1.0/createshared/(\d+)`, f.createSharedProject)
+	f.handle("GET", projectAPIEndpoint+`/([^/]+)`, f.getProject)
+	f.handle("PUT", projectAPIEndpoint+`/([^/]+)`, f.updateProject)
+	f.handle("PUT", projectAPIEndpoint+`/([^/]+)/type/([^/]+)`, f.updateProjectType)
+	f.handle("DELETE", projectAPIEndpoint+`/([^/]+)`, f.deleteProject)
+	f.handle("GET", projectAPIEndpoint+`/([^/]+)/(issuesecuritylevelscheme|notificationscheme|permissionscheme)`, f.getProjectScheme)
+	f.handle("GET", projectAPIEndpoint+`/([^/]+)/priorityscheme`, f.getProjectPriorityScheme)
+	f.handle("PUT", projectAPIEndpoint+`/([^/]+)/priorityscheme`, f.assignProjectPriorityScheme)
+	f.handle("GET", projectAPIEndpoint+`/([^/]+)/statuses`, f.getProjectStatuses)
+	f.handle("GET", projectAPIEndpoint+`/([^/]+)/role/(\d+)`, f.getProjectRole)
+	f.handle("POST", projectAPIEndpoint+`/([^/]+)/role/(\d+)`, f.addProjectRoleActor)
+	f.handle("DELETE", projectAPIEndpoint+`/([^/]+)/role/(\d+)`, f.removeProjectRoleActor)
+
+	f.handle("POST", issueAPIEndpoint, f.createIssue)
+	f.handle("GET", issueAPIEndpoint+`/([^/]+)`, f.getIssue)
+	f.handle("PUT", issueAPIEndpoint+`/([^/]+)`, f.updateIssue)
+	f.handle("DELETE", issueAPIEndpoint+`/([^/]+)`, f.deleteIssue)
+	f.handle("GET", issueAPIEndpoint+`/([^/]+)/transitions`, f.getTransitions)
+	f.handle("POST", issueAPIEndpoint+`/([^/]+)/transitions`, f.doTransition)
+	f.handle("POST", issueAPIEndpoint+`/([^/]+)/comment`, f.addComment)
+	f.handle("PUT", issueAPIEndpoint+`/([^/]+)/comment/(\d+)`, f.updateComment)
+	f.handle("DELETE", issueAPIEndpoint+`/([^/]+)/comment/(\d+)`, f.deleteComment)
+	f.handle("POST", issueAPIEndpoint+`/([^/]+)/attachments`, f.addAttachments)
+	f.handle("GET", issueAPIEndpoint+`/([^/]+)/watchers`, f.getWatchers)
+	f.handle("POST", issueAPIEndpoint+`/([^/]+)/watchers`, f.addWatcher)
+	f.handle("DELETE", issueAPIEndpoint+`/([^/]+)/watchers`, f.removeWatcher)
+	f.handle("GET", attachmentAPIEndpoint+`/(\d+)`, f.getAttachment)
+	f.handle("DELETE", attachmentAPIEndpoint+`/(\d+)`, f.deleteAttachment)
+	f.handle("GET", "/rest/api/2/search", f.search)
+
+	// Version 3 of the API differs in rich text only, which is exchanged in
+	// Atlassian Document Format
+	f.handle("POST", issueV3APIEndpoint, f.createIssue)
+	f.handle("GET", issueV3APIEndpoint+`/([^/]+)`, f.getIssue)
+	f.handle("PUT", issueV3APIEndpoint+`/([^/]+)`, f.updateIssue)
+	f.handle("POST", issueV3APIEndpoint+`/([^/]+)/comment`, f.addComment)
+	f.handle("GET", `/rest/api/[23]/issue/([^/]+)/comment/(\d+)`, f.getComment)
+	f.handle("PUT", issueV3APIEndpoint+`/([^/]+)/comment/(\d+)`, f.updateComment)
+
+	f.handle("GET", fieldAPIEndpoint, f.getFields)
+	f.handle("POST", fieldAPIEndpoint, f.createField)
+	f.handle("PUT", fieldAPIEndpoint+`/([^/]+)`, f.updateField)
+	f.handle("DELETE", fieldAPIEndpoint+`/([^/]+)`, f.deleteField)
+	f.handle("GET", fieldAPIEndpoint+`/([^/]+)/context`, f.getFieldContexts)
+	f.handle("POST", fieldAPIEndpoint+`/([^/]+)/context`, f.createFieldContext)
+	f.handle("GET", fieldAPIEndpoint+`/([^/]+)/context/(projectmapping|issuetypemapping)`, f.getFieldContextMappings)
+	f.handle("GET", fieldAPIEndpoint+`/([^/]+)/context/defaultValue`, f.getFieldContextDefaults)
+	f.handle("PUT", fieldAPIEndpoint+`/([^/]+)/context/defaultValue`, f.setFieldContextDefaults)
+	f.handle("PUT", fieldAPIEndpoint+`/([^/]+)/context/(\d+)`, f.updateFieldContext)
+	f.handle("DELETE", fieldAPIEndpoint+`/([^/]+)/context/(\d+)`, f.deleteFieldContext)
+	f.handle("PUT", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/(project|issuetype)`, f.addFieldContextMapping)
+	f.handle("POST", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/(project|issuetype)/remove`, f.removeFieldContextMapping)
+	f.handle("GET", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/option`, f.getFieldOptions)
+	f.handle("POST", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/option`, f.createFieldOptions)
+	f.handle("PUT", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/option`, f.updateFieldOptions)
+	f.handle("PUT", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/option/move`, f.moveFieldOptions)
+	f.handle("DELETE", fieldAPIEndpoint+`/([^/]+)/context/(\d+)/option/(\d+)`, f.deleteFieldOption)
+
+	f.handle("POST", workflowAPIEndpoint, f.createWorkflow)
+	f.handle("GET", workflowAPIEndpoint+"/search", f.searchWorkflows)
+	f.handle("DELETE", workflowAPIEndpoint+`/([^/]+)`, f.deleteWorkflow)
+	f.handle("GET", workflowSchemeAPIEndpoint+"/project", f.getProjectWorkflowScheme)
+	f.handle("POST", workflowSchemeAPIEndpoint+"/project/switch", f.switchProjectWorkflowScheme)
+	f.handle("POST", workflowSchemeAPIEndpoint+`/(\d+)/draft/publish`, f.publishWorkflowScheme)
+	f.handle("GET", taskAPIEndpoint+`/(\d+)`, f.getTask)
+
+	f.handle("POST", screenAPIEndpoint, f.createScreen)
+	f.handle("GET", screenAPIEndpoint, f.getScreens)
+	f.handle("PUT", screenAPIEndpoint+`/(\d+)`, f.updateScreen)
+	f.handle("DELETE", screenAPIEndpoint+`/(\d+)`, f.deleteScreen)
+	f.handle("GET", screenAPIEndpoint+`/(\d+)/tabs`, f.getScreenTabs)
+	f.handle("POST", screenAPIEndpoint+`/(\d+)/tabs`, f.createScreenTab)
+	f.handle("PUT", screenAPIEndpoint+`/(\d+)/tabs/(\d+)`, f.updateScreenTab)
+	f.handle("DELETE", screenAPIEndpoint+`/(\d+)/tabs/(\d+)`, f.deleteScreenTab)
+	f.handle("POST", screenAPIEndpoint+`/(\d+)/tabs/(\d+)/move/(\d+)`, f.moveScreenTab)
+	f.handle("GET", screenAPIEndpoint+`/(\d+)/tabs/(\d+)/fields`, f.getScreenTabFields)
+	f.handle("POST", screenAPIEndpoint+`/(\d+)/tabs/(\d+)/fields`, f.addScreenTabField)
+	f.handle("DELETE", screenAPIEndpoint+`/(\d+)/tabs/(\d+)/fields/([^/]+)`, f.removeScreenTabField)
+	f.handle("POST", screenAPIEndpoint+`/(\d+)/tabs/(\d+)/fields/([^/]+)/move`, f.moveScreenTabField)
+	f.handle("POST", screenSchemeAPIEndpoint, f.createScreenScheme)
+	f.handle("GET", screenSchemeAPIEndpoint, f.getScreenSchemes)
+	f.handle("PUT", screenSchemeAPIEndpoint+`/(\d+)`, f.updateScreenScheme)
+	f.handle("DELETE", screenSchemeAPIEndpoint+`/(\d+)`, f.deleteScreenScheme)
+	f.handle("POST", issueTypeScreenSchemeAPIEndpoint, f.createIssueTypeScreenScheme)
+	f.handle("GET", issueTypeScreenSchemeAPIEndpoint, f.getIssueTypeScreenSchemes)
+	f.handle("GET", issueTypeScreenSchemeAPIEndpoint+"/mapping", f.getIssueTypeScreenSchemeMappings)
+	f.handle("GET", issueTypeScreenSchemeAPIEndpoint+"/project", f.getProjectIssueTypeScreenSchemes)
+	f.handle("PUT", issueTypeScreenSchemeAPIEndpoint+"/project", f.assignProjectIssueTypeScreenScheme)
+	f.handle("PUT", issueTypeScreenSchemeAPIEndpoint+`/(\d+)`, f.updateIssueTypeScreenScheme)
+	f.handle("DELETE", issueTypeScreenSchemeAPIEndpoint+`/(\d+)`, f.deleteIssueTypeScreenScheme)
+	f.handle("PUT", issueTypeScreenSchemeAPIEndpoint+`/(\d+)/mapping`, f.addIssueTypeScreenSchemeMappings)
+	f.handle("PUT", issueTypeScreenSchemeAPIEndpoint+`/(\d+)/mapping/default`, f.setIssueTypeScreenSchemeDefault)
+	f.handle("POST", issueTypeScreenSchemeAPIEndpoint+`/(\d+)/mapping/remove`, f.removeIssueTypeScreenSchemeMappings)
+
+	f.handle("POST", fieldConfigurationAPIEndpoint, f.createFieldConfiguration)
+	f.handle("GET", fieldConfigurationAPIEndpoint, f.getFieldConfigurations)
+	f.handle("PUT", fieldConfigurationAPIEndpoint+`/(\d+)`, f.updateFieldConfiguration)
+	f.handle("DELETE", fieldConfigurationAPIEndpoint+`/(\d+)`, f.deleteFieldConfiguration)
+	f.handle("GET", fieldConfigurationAPIEndpoint+`/(\d+)/fields`, f.getFieldConfigurationItems)
+	f.handle("PUT", fieldConfigurationAPIEndpoint+`/(\d+)/fields`, f.updateFieldConfigurationItems)
+	f.handle("POST", fieldConfigurationSchemeAPIEndpoint, f.createFieldConfigurationScheme)
+	f.handle("GET", fieldConfigurationSchemeAPIEndpoint, f.getFieldConfigurationSchemes)
+	f.handle("GET", fieldConfigurationSchemeAPIEndpoint+"/mapping", f.getFieldConfigurationSchemeMappings)
+	f.handle("GET", fieldConfigurationSchemeAPIEndpoint+"/project", f.getProjectFieldConfigurationSchemes)
+	f.handle("PUT", fieldConfigurationSchemeAPIEndpoint+"/project", f.assignProjectFieldConfigurationScheme)
+	f.handle("PUT", fieldConfigurationSchemeAPIEndpoint+`/(\d+)`, f.updateFieldConfigurationScheme)
+	f.handle("DELETE", fieldConfigurationSchemeAPIEndpoint+`/(\d+)`, f.deleteFieldConfigurationScheme)
+	f.handle("PUT", fieldConfigurationSchemeAPIEndpoint+`/(\d+)/mapping`, f.updateFieldConfigurationSchemeMappings)
+	f.handle("POST", fieldConfigurationSchemeAPIEndpoint+`/(\d+)/mapping/delete`, f.removeFieldConfigurationSchemeMappings)
+
+	f.handle("GET", issueTypeAPIEndpoint, f.getIssueTypes)
+	f.handle("POST", issueTypeSchemeAPIEndpoint, f.createIssueTypeScheme)
+	f.handle("GET", issueTypeSchemeAPIEndpoint, f.getIssueTypeSchemes)
+	f.handle("GET", issueTypeSchemeAPIEndpoint+"/mapping", f.getIssueTypeSchemeMappings)
+	f.handle("GET", issueTypeSchemeAPIEndpoint+"/project", f.getProjectIssueTypeSchemes)
+	f.handle("PUT", issueTypeSchemeAPIEndpoint+"/project", f.assignProjectIssueTypeScheme)
+	f.handle("PUT", issueTypeSchemeAPIEndpoint+`/(\d+)`, f.updateIssueTypeScheme)
+	f.handle("DELETE", issueTypeSchemeAPIEndpoint+`/(\d+)`, f.deleteIssueTypeScheme)
+	f.handle("PUT", issueTypeSchemeAPIEndpoint+`/(\d+)/issuetype`, f.addIssueTypeSchemeTypes)
+	f.handle("PUT", issueTypeSchemeAPIEndpoint+`/(\d+)/issuetype/move`, f.moveIssueTypeSchemeTypes)
+	f.handle("DELETE", issueTypeSchemeAPIEndpoint+`/(\d+)/issuetype/(\d+)`, f.removeIssueTypeSchemeType)
+
+	f.handle("POST", issueLinkAPIEndpoint, f.createIssueLink)
+	f.handle("GET", issueLinkAPIEndpoint+`/(\d+)`, f.getIssueLink)
+	f.handle("DELETE", issueLinkAPIEndpoint+`/(\d+)`, f.deleteIssueLink)
+}
+
+// handle registers a handler for all requests with the given method whose path
+// matches the pattern
+func (f *fakeJira) handle(method, pattern string, handler fakeHandler) {
+	f.routes = append(f.routes, fakeRoute{
+		method:  method,
+		pattern: regexp.MustCompile("^/" + strings.TrimPrefix(pattern, "/") + "$"),
+		handler: handler,
+	})
+}
+
+func (f *fakeJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status, body := http.StatusNotFound, interface{}(fakeError("No route for %s %s", r.Method, r.URL.Path))
+	for _, route := range f.routes {
+		params := route.pattern.FindStringSubmatch(r.URL.Path)
+		if params == nil {
+			continue
+		}
+		if route.method != r.Method {
+			status, body = http.StatusMethodNotAllowed, fakeError("Method %s not allowed", r.Method)
+			continue
+		}
+		status, body = route.handler(w, r, params[1:])
+		break
+	}
+
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// fakeError builds an error collection as returned by Jira
+func fakeError(format string, a ...interface{}) fakeObject {
+	return fakeObject{
+		"errorMessages": []string{fmt.Sprintf(format, a...)},
+		"errors":        map[string]string{},
+	}
+}
+
+// fakeFieldError builds an error collection rejecting a single field
+func fakeFieldError(field, format string, a ...interface{}) fakeObject {
+	return fakeObject{
+		"errorMessages": []string{},
+		"errors":        map[string]string{field: fmt.Sprintf(format, a...)},
+	}
+}
+
+// fakeRequireAdministrator rejects requests of users other than admin, e.g.
+// reading workflows, which requires the Administer Jira global permission
+func fakeRequireAdministrator(r *http.Request) fakeObject {
+	if user, _, ok := r.BasicAuth(); ok && user != "admin" {
+		return fakeError("You are not authorized to perform this action. Administrator privileges are required.")
+	}
+	return nil
+}
+
+func decodeFakeBody(r *http.Request) (fakeObject, fakeObject) {
+	body := fakeObject{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fakeError("Unexpected request body: %s", err)
+	}
+	return body, nil
+}
+
+// nextID returns a new, unique ID
+func (f *fakeJira) nextID() int {
+	f.lastID++
+	return f.lastID
+}
+
+// insert adds the object to the collection and assigns an ID
+func (f *fakeJira) insert(path string, c fakeCollection, numericID bool, obj fakeObject) fakeObject {
+	id := f.nextID()
+	idStr := strconv.Itoa(id)
+
+	obj["id"] = idStr
+	if numericID {
+		obj["id"] = id
+	}
+	obj["self"] = fmt.Sprintf("%s/%s/%s", f.URL, strings.Trim(path, "/"), idStr)
+
+	c[idStr] = obj
+	return obj
+}
+
+// crud registers the create, read, update and delete endpoints of a plain
+// collection located at path
+func (f *fakeJira) crud(path string, c fakeCollection, opts fakeCollectionOptions) {
+	prepare := func(obj, body fakeObject) fakeObject {
+		if opts.prepare == nil {
+			return nil
+		}
+		return opts.prepare(obj, body)
+	}
+
+	f.handle("POST", path, func(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+		body, errs := decodeFakeBody(r)
+		if errs == nil {
+			errs = prepare(nil, body)
+		}
+		if errs != nil {
+			return http.StatusBadRequest, errs
+		}
+		return http.StatusCreated, f.insert(path, c, opts.numericIDs, body)
+	})
+
+	f.handle("GET", path+`/(\d+)`, func(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+		obj, ok := c[params[0]]
+		if !ok {
+			return http.StatusNotFound, fakeError("The requested entity %s does not exist", params[0])
+		}
+		return http.StatusOK, obj
+	})
+
+	f.handle("PUT", path+`/(\d+)`, func(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+		obj, ok := c[params[0]]
+		if !ok {
+			return http.StatusNotFound, fakeError("The requested entity %s does not exist", params[0])
+		}
+
+		body, errs := decodeFakeBody(r)
+		if errs == nil {
+			errs = prepare(obj, body)
+		}
+		if errs != nil {
+			return http.StatusBadRequest, errs
+		}
+
+		for k, v := range body {
+			if k != "id" && k != "self" {
+				obj[k] = v
+			}
+		}
+		return http.StatusOK, obj
+	})
+
+	f.handle("DELETE", path+`/(\d+)`, func(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+		if _, ok := c[params[0]]; !ok {
+			return http.StatusNotFound, fakeError("The requested entity %s does not exist", params[0])
+		}
+		delete(c, params[0])
+		return http.StatusNoContent, nil
+	})
+}
+
+// find returns the first object of the collection with a matching attribute
+func (c fakeCollection) find(attribute string, value interface{}) fakeObject {
+	for _, id := range sortedKeys(c) {
+		if c[id][attribute] == value {
+			return c[id]
+		}
+	}
+	return nil
+}
+
+// findFold finds an object by the value of the given attribute, ignoring case
+func (c fakeCollection) findFold(attribute string, value string) fakeObject {
+	for _, id := range sortedKeys(c) {
+		if v, ok := c[id][attribute].(string); ok && strings.EqualFold(v, value) {
+			return c[id]
+		}
+	}
+	return nil
+}
+
+// lookup finds an object by its ID or by the value of the given attribute
+func (c fakeCollection) lookup(attribute string, idOrValue string) fakeObject {
+	if obj, ok := c[idOrValue]; ok {
+		return obj
+	}
+	return c.find(attribute, idOrValue)
+}
+
+// reference returns a nested object referencing other entities, e.g. the
+// project of an issue
+func reference(v interface{}) fakeObject {
+	if m, ok := v.(map[string]interface{}); ok {
+		return fakeObject(m)
+	}
+	if m, ok := v.(fakeObject); ok {
+		return m
+	}
+	return fakeObject{}
+}
+
+func (f *fakeJira) prepareComponent(obj, body fakeObject) fakeObject {
+	if key, ok := body["project"]; ok {
+		project := f.projects.find("key", key)
+		if project == nil {
+			return fakeFieldError("project", "The project %v does not exist", key)
+		}
+		body["projectId"], _ = strconv.Atoi(project["id"].(string))
+	}
+
+	if lead, ok := body["leadUserName"]; ok {
+		delete(body, "leadUserName")
+		if lead != "" {
+			if f.users.find("name", lead) == nil {
+				return fakeFieldError("leadUserName", "The user %v does not exist", lead)
+			}
+			body["lead"] = fakeObject{"name": lead}
+		}
+	}
+	return nil
+}
+
+func prepareFilter(obj, body fakeObject) fakeObject {
+	// permissions are managed by dedicated endpoints
+	delete(body, "sharePermissions")
+	return nil
+}
+
+func prepareIssueType(obj, body fakeObject) fakeObject {
+	if t, ok := body["type"]; ok {
+		delete(body, "type")
+		body["subtask"] = t == "subtask"
+	}
+	return nil
+}
+
+func (f *fakeJira) prepareVersion(obj, body fakeObject) fakeObject {
+	if obj == nil {
+		project := f.projects.find("key", body["project"])
+		if project == nil {
+			return fakeFieldError("project", "Project must be specified to create a version.")
+		}
+		delete(body, "project")
+		body["projectId"], _ = strconv.Atoi(project["id"].(string))
+
+		for _, flag := range []string{"released", "archived"} {
+			if _, ok := body[flag]; !ok {
+				body[flag] = false
+			}
+		}
+	}
+
+	if target, ok := body["moveUnfixedIssuesTo"].(string); ok {
+		delete(body, "moveUnfixedIssuesTo")
+
+		targetVersion := f.versions[target[strings.LastIndex(target, "/")+1:]]
+		if targetVersion == nil || obj == nil {
+			return fakeFieldError("moveUnfixedIssuesTo", "The version to move unfixed issues to does not exist.")
+		}
+
+		for _, issue := range f.issues {
+			fields := reference(issue["fields"])
+			if reference(fields["status"])["name"] == "Done" {
+				continue
+			}
+
+			fixVersions, _ := fields["fixVersions"].([]interface{})
+			for i, v := range fixVersions {
+				if reference(v)["id"] == obj["id"] {
+					fixVersions[i] = fakeObject{"id": targetVersion["id"], "name": targetVersion["name"], "self": targetVersion["self"]}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateHolder validates the holder of a permission grant or of an issue
+// security level
+func (f *fakeJira) validateHolder(holder fakeObject) fakeObject {
+	parameter, _ := holder["parameter"].(string)
+
+	switch holder["type"] {
+	case "group":
+		if _, ok := f.groups[parameter]; !ok {
+			return fakeError("The group %s does not exist.", parameter)
+		}
+	case "projectRole":
+		if _, ok := f.roles[parameter]; !ok {
+			return fakeError("The project role %s does not exist.", parameter)
+		}
+	case "user", "reporter", "assignee", "projectLead", "applicationRole":
+	default:
+		return fakeError("Invalid holder type %v", holder["type"])
+	}
+	return nil
+}
+
+// preparePermissionGrant validates the holder of a grant and assigns an ID
+func (f *fakeJira) preparePermissionGrant(grant fakeObject) fakeObject {
+	if errs := f.validateHolder(reference(grant["holder"])); errs != nil {
+		return errs
+	}
+
+	if permission, _ := grant["permission"].(string); permission == "" {
+		return fakeFieldError("permission", "The permission must be specified.")
+	}
+
+	grant["id"] = f.nextID()
+	return nil
+}
+
+func (f *fakeJira) preparePermissionScheme(obj, body fakeObject) fakeObject {
+	if obj == nil {
+		if name, _ := body["name"].(string); name == "" {
+			return fakeFieldError("name", "The permission scheme name must be specified.")
+		}
+	}
+
+	permissions, _ := body["permissions"].([]interface{})
+	for _, grant := range permissions {
+		if errs := f.preparePermissionGrant(reference(grant)); errs != nil {
+			return errs
+		}
+	}
+	if obj == nil && permissions == nil {
+		body["permissions"] = []interface{}{}
+	}
+	return nil
+}
+
+func (f *fakeJira) addPermissionGrant(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.permissionSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The permission scheme %s does not exist.", params[0])
+	}
+
+	grant, errs := decodeFakeBody(r)
+	if errs == nil {
+		errs = f.preparePermissionGrant(grant)
+	}
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	scheme["permissions"] = append(scheme["permissions"].([]interface{}), grant)
+	return http.StatusCreated, grant
+}
+
+func (f *fakeJira) deletePermissionGrant(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.permissionSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The permission scheme %s does not exist.", params[0])
+	}
+
+	permissions := scheme["permissions"].([]interface{})
+	for i, grant := range permissions {
+		if fmt.Sprintf("%v", reference(grant)["id"]) == params[1] {
+			scheme["permissions"] = append(permissions[:i], permissions[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, fakeError("The permission grant %s does not exist.", params[1])
+}
+
+// prepareNotificationSchemeEvents validates the events and assigns IDs to the
+// notifications
+func (f *fakeJira) prepareNotificationSchemeEvents(events []interface{}) fakeObject {
+	for _, e := range events {
+		event := reference(e)
+		if _, ok := reference(event["event"])["id"].(float64); !ok {
+			return fakeFieldError("event", "The event ID must be specified.")
+		}
+
+		notifications, _ := event["notifications"].([]interface{})
+		for _, n := range notifications {
+			notification := reference(n)
+			if notificationType, _ := notification["notificationType"].(string); notificationType == "" {
+				return fakeFieldError("notificationType", "The notification type must be specified.")
+			}
+			notification["id"] = f.nextID()
+		}
+	}
+	return nil
+}
+
+func (f *fakeJira) prepareNotificationScheme(obj, body fakeObject) fakeObject {
+	if obj == nil {
+		if name, _ := body["name"].(string); name == "" {
+			return fakeFieldError("name", "The notification scheme name must be specified.")
+		}
+	}
+
+	events, _ := body["notificationSchemeEvents"].([]interface{})
+	if errs := f.prepareNotificationSchemeEvents(events); errs != nil {
+		return errs
+	}
+	if obj == nil && events == nil {
+		body["notificationSchemeEvents"] = []interface{}{}
+	}
+	return nil
+}
+
+func (f *fakeJira) addNotifications(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.notificationSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The notification scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	added, _ := body["notificationSchemeEvents"].([]interface{})
+	if errs := f.prepareNotificationSchemeEvents(added); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	// Notifications for an event already in the scheme are merged into it
+	events := scheme["notificationSchemeEvents"].([]interface{})
+	for _, a := range added {
+		event := reference(a)
+		merged := false
+		for _, e := range events {
+			existing := reference(e)
+			if reference(existing["event"])["id"] == reference(event["event"])["id"] {
+				existing["notifications"] = append(existing["notifications"].([]interface{}), event["notifications"].([]interface{})...)
+				merged = true
+			}
+		}
+		if !merged {
+			events = append(events, event)
+		}
+	}
+	scheme["notificationSchemeEvents"] = events
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteNotification(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.notificationSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The notification scheme %s does not exist.", params[0])
+	}
+
+	for _, e := range scheme["notificationSchemeEvents"].([]interface{}) {
+		event := reference(e)
+		notifications := event["notifications"].([]interface{})
+		for i, notification := range notifications {
+			if fmt.Sprintf("%v", reference(notification)["id"]) == params[1] {
+				event["notifications"] = append(notifications[:i], notifications[i+1:]...)
+				return http.StatusNoContent, nil
+			}
+		}
+	}
+	return http.StatusNotFound, fakeError("The notification %s does not exist.", params[1])
+}
+
+func (f *fakeJira) createSecurityScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs == nil {
+		errs = prepareSecurityScheme(nil, body)
+	}
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	scheme := f.insert(issueSecuritySchemeAPIEndpoint, f.securitySchemes, true, body)
+	scheme["levels"] = []interface{}{}
+	return http.StatusCreated, fakeObject{"id": strconv.Itoa(scheme["id"].(int)), "self": scheme["self"]}
+}
+
+func prepareSecurityScheme(obj, body fakeObject) fakeObject {
+	if obj == nil {
+		if name, _ := body["name"].(string); name == "" {
+			return fakeFieldError("name", "The issue security scheme name must be specified.")
+		}
+	}
+	delete(body, "levels")
+	delete(body, "defaultSecurityLevelId")
+	return nil
+}
+
+// securityLevel resolves the issue security scheme and the level referenced by
+// the parameters
+func (f *fakeJira) securityLevel(params []string) (fakeObject, fakeObject, fakeObject) {
+	scheme, ok := f.securitySchemes[params[0]]
+	if !ok {
+		return nil, nil, fakeError("The issue security scheme %s does not exist.", params[0])
+	}
+	for _, level := range scheme["levels"].([]interface{}) {
+		if reference(level)["id"] == params[1] {
+			return scheme, reference(level), nil
+		}
+	}
+	return nil, nil, fakeError("The issue security level %s does not exist.", params[1])
+}
+
+// findSecurityLevel returns the issue security scheme and level with the ID
+func (f *fakeJira) findSecurityLevel(id string) (fakeObject, fakeObject) {
+	for _, schemeID := range sortedKeys(f.securitySchemes) {
+		if scheme, level, errs := f.securityLevel([]string{schemeID, id}); errs == nil {
+			return scheme, level
+		}
+	}
+	return nil, nil
+}
+
+// setSecurityLevelDefault makes the level the default of the scheme or
+// removes it as the default
+func setSecurityLevelDefault(scheme fakeObject, levelID string, isDefault bool) {
+	id, _ := strconv.Atoi(levelID)
+	if isDefault {
+		scheme["defaultSecurityLevelId"] = id
+	} else if scheme["defaultSecurityLevelId"] == id {
+		delete(scheme, "defaultSecurityLevelId")
+	}
+}
+
+// addSecurityLevelMember validates the holder of a member and adds it to the level
+func (f *fakeJira) addSecurityLevelMember(levelID string, holder fakeObject) fakeObject {
+	if errs := f.validateHolder(holder); errs != nil {
+		return errs
+	}
+	id, _ := strconv.Atoi(levelID)
+	f.securityMembers[levelID] = append(f.securityMembers[levelID], fakeObject{
+		"id":                   f.nextID(),
+		"issueSecurityLevelId": id,
+		"holder":               fakeObject{"type": holder["type"], "parameter": holder["parameter"]},
+	})
+	return nil
+}
+
+func (f *fakeJira) addSecurityLevels(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.securitySchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue security scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	added, _ := body["levels"].([]interface{})
+	for _, l := range added {
+		name, _ := reference(l)["name"].(string)
+		if name == "" {
+			return http.StatusBadRequest, fakeFieldError("name", "The issue security level name must be specified.")
+		}
+		for _, level := range scheme["levels"].([]interface{}) {
+			if reference(level)["name"] == name {
+				return http.StatusBadRequest, fakeFieldError("name", "An issue security level with the name '%s' already exists.", name)
+			}
+		}
+		members, _ := reference(l)["members"].([]interface{})
+		for _, member := range members {
+			if errs := f.validateHolder(reference(member)); errs != nil {
+				return http.StatusBadRequest, errs
+			}
+		}
+	}
+
+	for _, l := range added {
+		request := reference(l)
+		id := strconv.Itoa(f.nextID())
+		scheme["levels"] = append(scheme["levels"].([]interface{}), fakeObject{
+			"id":          id,
+			"self":        fmt.Sprintf("%s/rest/api/2/securitylevel/%s", f.URL, id),
+			"name":        request["name"],
+			"description": request["description"],
+		})
+		members, _ := request["members"].([]interface{})
+		for _, member := range members {
+			f.addSecurityLevelMember(id, reference(member))
+		}
+		if isDefault, _ := request["isDefault"].(bool); isDefault {
+			setSecurityLevelDefault(scheme, id, true)
+		}
+	}
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) setSecurityLevelDefaults(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	defaults, _ := body["defaultValues"].([]interface{})
+	for _, d := range defaults {
+		levelID := fmt.Sprintf("%v", reference(d)["issueSecurityLevelId"])
+		scheme, _, errs := f.securityLevel([]string{params[0], levelID})
+		if errs != nil {
+			return http.StatusNotFound, errs
+		}
+		isDefault, _ := reference(d)["isDefault"].(bool)
+		setSecurityLevelDefault(scheme, levelID, isDefault)
+	}
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) updateSecurityLevel(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	_, level, errs := f.securityLevel(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	for _, attribute := range []string{"name", "description"} {
+		if v, ok := body[attribute]; ok {
+			level[attribute] = v
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteSecurityLevel(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, _, errs := f.securityLevel(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	levels := scheme["levels"].([]interface{})
+	for i, level := range levels {
+		if reference(level)["id"] == params[1] {
+			scheme["levels"] = append(levels[:i], levels[i+1:]...)
+		}
+	}
+	setSecurityLevelDefault(scheme, params[1], false)
+	delete(f.securityMembers, params[1])
+
+	// Issues with the deleted level become visible to everyone
+	for _, id := range sortedKeys(f.issues) {
+		fields := reference(f.issues[id]["fields"])
+		if reference(fields["security"])["id"] == params[1] {
+			fields["security"] = nil
+		}
+	}
+
+	return http.StatusAccepted, nil
+}
+
+func (f *fakeJira) getSecurityLevelMembers(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	query := r.URL.Query()
+	if _, _, errs := f.securityLevel([]string{query.Get("schemeId"), query.Get("levelId")}); errs != nil {
+		return http.StatusNotFound, errs
+	}
+	return http.StatusOK, fakePage(f.securityMembers[query.Get("levelId")])
+}
+
+func (f *fakeJira) addSecurityLevelMembers(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, _, errs := f.securityLevel(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	members, _ := body["members"].([]interface{})
+	for _, member := range members {
+		if errs := f.validateHolder(reference(member)); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+	}
+	for _, member := range members {
+		f.addSecurityLevelMember(params[1], reference(member))
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteSecurityLevelMember(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, _, errs := f.securityLevel(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	members := f.securityMembers[params[1]]
+	for i, member := range members {
+		if strconv.Itoa(member["id"].(int)) == params[2] {
+			f.securityMembers[params[1]] = append(members[:i], members[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, fakeError("The issue security level member %s does not exist.", params[2])
+}
+
+func (f *fakeJira) addFilterPermission(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	filter, ok := f.filters[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The selected filter is not available to you, perhaps it has been deleted or had its permissions changed.")
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	permission := fakeObject{"id": f.nextID(), "type": body["type"]}
+	switch body["type"] {
+	case "authenticated":
+		permission["type"] = "loggedin"
+	case "global":
+	case "group":
+		permission["group"] = fakeObject{"name": body["groupname"]}
+	case "project":
+		permission["project"] = fakeObject{"id": body["projectId"]}
+	case "project_role":
+		permission["type"] = "project"
+		permission["project"] = fakeObject{"id": body["projectId"]}
+		roleID, _ := strconv.Atoi(fmt.Sprintf("%v", body["projectRoleId"]))
+		permission["role"] = fakeObject{"id": roleID}
+	default:
+		return http.StatusBadRequest, fakeFieldError("type", "Invalid permission type %v", body["type"])
+	}
+
+	permissions, _ := filter["sharePermissions"].([]interface{})
+	filter["sharePermissions"] = append(permissions, permission)
+
+	return http.StatusCreated, filter["sharePermissions"]
+}
+
+func (f *fakeJira) deleteFilterPermission(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	filter, ok := f.filters[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The selected filter is not available to you, perhaps it has been deleted or had its permissions changed.")
+	}
+
+	permissions, _ := filter["sharePermissions"].([]interface{})
+	for i, p := range permissions {
+		if fmt.Sprintf("%v", reference(p)["id"]) == params[1] {
+			filter["sharePermissions"] = append(permissions[:i], permissions[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, fakeError("Permission %s does not exist", params[1])
+}
+
+// findUser resolves the user referenced by the query parameters key, username
+// or accountId
+func (f *fakeJira) findUser(r *http.Request) fakeObject {
+	query := r.URL.Query()
+	if key := query.Get("key"); key != "" {
+		return f.users[key]
+	}
+	if name := query.Get("username"); name != "" {
+		return f.users.find("name", name)
+	}
+	if accountID := query.Get("accountId"); accountID != "" {
+		return f.users.find("accountId", accountID)
+	}
+	return nil
+}
+
+func (f *fakeJira) createUser(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		return http.StatusBadRequest, fakeFieldError("username", "You must specify a username.")
+	}
+	if f.users.find("name", name) != nil {
+		return http.StatusBadRequest, fakeFieldError("username", "A user with that username already exists.")
+	}
+
+	key := fmt.Sprintf("JIRAUSER%d", f.nextID())
+	user := fakeObject{
+		"self":         fmt.Sprintf("%s%s?key=%s", f.URL, userAPIEndpoint, key),
+		"key":          key,
+		"name":         name,
+		"emailAddress": body["emailAddress"],
+		"displayName":  body["displayName"],
+		"active":       true,
+	}
+	f.users[key] = user
+
+	return http.StatusCreated, user
+}
+
+func (f *fakeJira) getUser(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	user := f.findUser(r)
+	if user == nil {
+		return http.StatusNotFound, fakeError("The user does not exist")
+	}
+
+	user = f.visibleUser(user)
+	if r.URL.Query().Get("expand") != "groups" {
+		return http.StatusOK, user
+	}
+
+	groups := []fakeObject{}
+	for _, name := range sortedKeys(f.groups) {
+		for _, member := range f.groups[name]["members"].([]string) {
+			if member == user["name"] {
+				groups = append(groups, fakeObject{"name": name})
+			}
+		}
+	}
+
+	expanded := fakeObject{"groups": fakeObject{"size": len(groups), "items": groups}}
+	for k, v := range user {
+		expanded[k] = v
+	}
+	return http.StatusOK, expanded
+}
+
+// visibleUser returns the user without a hidden email address
+func (f *fakeJira) visibleUser(user fakeObject) fakeObject {
+	if !f.hiddenEmails[fmt.Sprintf("%v", user["key"])] {
+		return user
+	}
+	visible := fakeObject{}
+	for k, v := range user {
+		if k != "emailAddress" {
+			visible[k] = v
+		}
+	}
+	return visible
+}
+
+// searchUsers matches the username or query parameter against names, display
+// names and email addresses
+func (f *fakeJira) searchUsers(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	query := r.URL.Query()
+	term := strings.ToLower(query.Get("username"))
+	if term == "" {
+		term = strings.ToLower(query.Get("query"))
+	}
+	if term == "" {
+		return http.StatusBadRequest, fakeError("The username query parameter was not provided")
+	}
+
+	users := []fakeObject{}
+	for _, key := range sortedKeys(f.users) {
+		user := f.users[key]
+		for _, attribute := range []string{"name", "displayName", "emailAddress"} {
+			if value, _ := user[attribute].(string); strings.Contains(strings.ToLower(value), term) {
+				users = append(users, f.visibleUser(user))
+				break
+			}
+		}
+	}
+	return http.StatusOK, users
+}
+
+func (f *fakeJira) deleteUser(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	user := f.findUser(r)
+	if user == nil {
+		return http.StatusNotFound, fakeError("The user does not exist")
+	}
+
+	for _, group := range f.groups {
+		group["members"] = without(group["members"].([]string), user["name"].(string))
+	}
+	delete(f.users, user["key"].(string))
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) createGroup(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "You must specify a group name.")
+	}
+	if _, ok := f.groups[name]; ok {
+		return http.StatusBadRequest, fakeError("A group or user with this name already exists.")
+	}
+
+	f.groups[name] = fakeObject{"name": name, "members": []string{}}
+	return http.StatusCreated, fakeObject{"name": name}
+}
+
+func (f *fakeJira) getGroupMembers(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	name := r.URL.Query().Get("groupname")
+	group, ok := f.groups[name]
+	if !ok {
+		return http.StatusNotFound, fakeError("The group %s does not exist.", name)
+	}
+
+	members := []fakeObject{}
+	for _, member := range group["members"].([]string) {
+		members = append(members, f.users.find("name", member))
+	}
+
+	return http.StatusOK, fakeObject{
+		"startAt":    0,
+		"maxResults": 50,
+		"total":      len(members),
+		"isLast":     true,
+		"values":     members,
+	}
+}
+
+func (f *fakeJira) deleteGroup(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	name := r.URL.Query().Get("groupname")
+	if _, ok := f.groups[name]; !ok {
+		return http.StatusNotFound, fakeError("The group %s does not exist.", name)
+	}
+
+	delete(f.groups, name)
+	return http.StatusOK, nil
+}
+
+func (f *fakeJira) addGroupMember(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	name := r.URL.Query().Get("groupname")
+	group, ok := f.groups[name]
+	if !ok {
+		return http.StatusNotFound, fakeError("The group %s does not exist.", name)
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	username, _ := body["name"].(string)
+	if f.users.find("name", username) == nil {
+		return http.StatusNotFound, fakeError("The user %s does not exist.", username)
+	}
+
+	members := group["members"].([]string)
+	for _, member := range members {
+		if member == username {
+			return http.StatusBadRequest, fakeError("Cannot add user. '%s' is already a member of '%s'", username, name)
+		}
+	}
+	group["members"] = append(members, username)
+
+	return http.StatusCreated, fakeObject{"name": name}
+}
+
+func (f *fakeJira) removeGroupMember(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	query := r.URL.Query()
+	group, ok := f.groups[query.Get("groupname")]
+	if !ok {
+		return http.StatusNotFound, fakeError("The group %s does not exist.", query.Get("groupname"))
+	}
+
+	members := group["members"].([]string)
+	remaining := without(members, query.Get("username"))
+	if len(remaining) == len(members) {
+		return http.StatusNotFound, fakeError("The user %s is not a member of the group.", query.Get("username"))
+	}
+	group["members"] = remaining
+
+	return http.StatusOK, nil
+}
+
+// applyProjectRequest updates the project using the attributes of a ProjectRequest
+func (f *fakeJira) applyProjectRequest(project fakeObject, body fakeObject) fakeObject {
+	for _, attribute := range []string{"key", "name", "projectTypeKey", "description", "url", "assigneeType"} {
+		if v, ok := body[attribute]; ok {
+			project[attribute] = v
+		}
+	}
+
+	for _, scheme := range []string{"issueSecurityScheme", "notificationScheme", "permissionScheme"} {
+		if v, ok := body[scheme]; ok {
+			project[scheme] = int(v.(float64))
+		}
+	}
+
+	if id, ok := project["permissionScheme"].(int); ok && id != 0 {
+		if _, ok := f.permissionSchemes[strconv.Itoa(id)]; !ok {
+			return fakeFieldError("permissionScheme", "The permission scheme %d does not exist.", id)
+		}
+	}
+
+	if id, ok := project["notificationScheme"].(int); ok && id != 0 {
+		if _, ok := f.notificationSchemes[strconv.Itoa(id)]; !ok {
+			return fakeFieldError("notificationScheme", "The notification scheme %d does not exist.", id)
+		}
+	}
+
+	if id, ok := project["issueSecurityScheme"].(int); ok && id != 0 {
+		if _, ok := f.securitySchemes[strconv.Itoa(id)]; !ok {
+			return fakeFieldError("issueSecurityScheme", "The issue security scheme %d does not exist.", id)
+		}
+	}
+
+	if lead, ok := body["lead"]; ok {
+		user := f.users.find("name", lead)
+		if user == nil {
+			return fakeFieldError("projectLead", "The user %v does not exist.", lead)
+		}
+		project["lead"] = fakeObject{"key": user["key"], "name": user["name"]}
+	}
+
+	if categoryID, ok := body["categoryId"]; ok {
+		category, ok := f.projectCategories[fmt.Sprintf("%v", categoryID)]
+		if !ok {
+			return fakeFieldError("categoryId", "The project category %v does not exist.", categoryID)
+		}
+		project["projectCategory"] = category
+	}
+
+	return nil
+}
+
+func (f *fakeJira) createProject(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	if key, _ := body["key"].(string); key == "" {
+		return http.StatusBadRequest, fakeFieldError("projectKey", "You must specify a unique project key.")
+	} else if f.projects.find("key", key) != nil {
+		return http.StatusBadRequest, fakeFieldError("projectKey", "Project '%s' uses this project key.", key)
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("projectName", "You must specify a valid project name.")
+	}
+
+	project := fakeObject{"assigneeType": "UNASSIGNED"}
+	if errs := f.applyProjectRequest(project, body); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	f.insert(projectAPIEndpoint, f.projects, false, project)
+
+	id, _ := strconv.Atoi(project["id"].(string))
+	return http.StatusCreated, fakeObject{"id": id, "key": project["key"], "self": project["self"]}
+}
+
+func (f *fakeJira) createSharedProject(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	shared, ok := f.projects[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("No project could be found with id '%s'.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	key, _ := body["key"].(string)
+	if f.projects.find("key", key) != nil {
+		return http.StatusBadRequest, fakeFieldError("projectKey", "Project '%s' uses this project key.", key)
+	}
+
+	project := fakeObject{
+		"projectTypeKey": shared["projectTypeKey"],
+		"assigneeType":   shared["assigneeType"],
+	}
+	if errs := f.applyProjectRequest(project, body); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	f.insert(projectAPIEndpoint, f.projects, false, project)
+
+	id, _ := strconv.Atoi(project["id"].(string))
+	return http.StatusOK, fakeObject{"projectId": id, "key": project["key"]}
+}
+
+func (f *fakeJira) getProject(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	issueTypes := []fakeObject{}
+	for _, id := range f.issueTypeSchemeTypes(fmt.Sprintf("%v", f.projectIssueTypeScheme(project)["id"])) {
+		issueTypes = append(issueTypes, f.issueTypes[id])
+	}
+
+	components := []fakeObject{}
+	for _, id := range sortedKeys(f.components) {
+		if f.components[id]["project"] == project["key"] {
+			components = append(components, f.components[id])
+		}
+	}
+
+	versions := []fakeObject{}
+	for _, id := range sortedKeys(f.versions) {
+		if fmt.Sprintf("%v", f.versions[id]["projectId"]) == project["id"] {
+			versions = append(versions, f.versions[id])
+		}
+	}
+
+	expanded := fakeObject{"issueTypes": issueTypes, "components": components, "versions": versions}
+	for k, v := range project {
+		expanded[k] = v
+	}
+	return http.StatusOK, expanded
+}
+
+func (f *fakeJira) updateProject(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	if key, ok := body["key"]; ok {
+		if other := f.projects.find("key", key); other != nil && other["id"] != project["id"] {
+			return http.StatusBadRequest, fakeFieldError("projectKey", "Project '%s' uses this project key.", key)
+		}
+	}
+
+	if errs := f.applyProjectRequest(project, body); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	return http.StatusOK, project
+}
+
+func (f *fakeJira) updateProjectType(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	project["projectTypeKey"] = params[1]
+	return http.StatusOK, project
+}
+
+func (f *fakeJira) deleteProject(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	for id, issue := range f.issues {
+		if reference(reference(issue["fields"])["project"])["id"] == project["id"] {
+			delete(f.issues, id)
+		}
+	}
+	for id, component := range f.components {
+		if component["project"] == project["key"] {
+			delete(f.components, id)
+		}
+	}
+	for id, version := range f.versions {
+		if fmt.Sprintf("%v", version["projectId"]) == project["id"] {
+			delete(f.versions, id)
+		}
+	}
+	delete(f.projects, project["id"].(string))
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getProjectScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	attribute := map[string]string{
+		"issuesecuritylevelscheme": "issueSecurityScheme",
+		"notificationscheme":       "notificationScheme",
+		"permissionscheme":         "permissionScheme",
+	}[params[1]]
+
+	id, ok := project[attribute]
+	if !ok || id == 0 {
+		return http.StatusNotFound, fakeError("No %s is associated with the project.", params[1])
+	}
+	return http.StatusOK, fakeObject{"id": id}
+}
+
+// projectRole resolves the project and the role referenced by the parameters
+func (f *fakeJira) projectRole(params []string) (string, fakeObject, fakeObject) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return "", nil, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	role, ok := f.roles[params[1]]
+	if !ok {
+		return "", nil, fakeError("Can not retrieve a role actor for a null project role.")
+	}
+
+	return fmt.Sprintf("%s/%s", project["id"], params[1]), role, nil
+}
+
+func (f *fakeJira) projectRoleResponse(key string, role fakeObject) fakeObject {
+	actors := f.projectRoleActors[key]
+	if actors == nil {
+		actors = []fakeObject{}
+	}
+	return fakeObject{
+		"id":          role["id"],
+		"name":        role["name"],
+		"description": role["description"],
+		"self":        role["self"],
+		"actors":      actors,
+	}
+}
+
+func (f *fakeJira) getProjectRole(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	key, role, errs := f.projectRole(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	return http.StatusOK, f.projectRoleResponse(key, role)
+}
+
+func (f *fakeJira) addProjectRoleActor(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	key, role, errs := f.projectRole(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	for actorType, field := range map[string]string{"user": "atlassian-user-role-actor", "group": "atlassian-group-role-actor"} {
+		names, _ := body[actorType].([]interface{})
+		for _, name := range names {
+			if actorType == "user" && f.users.find("name", name) == nil {
+				return http.StatusBadRequest, fakeError("We can't find '%v' in any accessible user directory.", name)
+			}
+			if _, ok := f.groups[fmt.Sprintf("%v", name)]; actorType == "group" && !ok {
+				return http.StatusBadRequest, fakeError("We can't find '%v' in any accessible group directory.", name)
+			}
+
+			f.projectRoleActors[key] = append(f.projectRoleActors[key], fakeObject{
+				"id":   f.nextID(),
+				"name": name,
+				"type": field,
+			})
+		}
+	}
+
+	return http.StatusOK, f.projectRoleResponse(key, role)
+}
+
+func (f *fakeJira) removeProjectRoleActor(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	key, _, errs := f.projectRole(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	query := r.URL.Query()
+	actorType, name := "atlassian-user-role-actor", query.Get("user")
+	if query.Get("group") != "" {
+		actorType, name = "atlassian-group-role-actor", query.Get("group")
+	}
+
+	actors := f.projectRoleActors[key]
+	for i, actor := range actors {
+		if actor["type"] == actorType && actor["name"] == name {
+			f.projectRoleActors[key] = append(actors[:i], actors[i+1:]...)
+			return http.StatusNoContent, nil
+		}
+	}
+	return http.StatusNotFound, fakeError("The actor %s is not a member of the role.", name)
+}
+
+// applyIssueFields updates the fields of the issue, resolving the references
+// to other entities
+func (f *fakeJira) applyIssueFields(fields fakeObject, update fakeObject) fakeObject {
+	for k, v := range update {
+		switch k {
+		case "project":
+			ref := reference(v)
+			project := f.projects.lookup("key", fmt.Sprintf("%v", ref["key"]))
+			if ref["id"] != nil {
+				project = f.projects[fmt.Sprintf("%v", ref["id"])]
+			}
+			if project == nil {
+				return fakeFieldError("project", "valid project is required")
+			}
+			fields["project"] = fakeObject{"id": project["id"], "key": project["key"], "name": project["name"]}
+		case "issuetype":
+			ref := reference(v)
+			issueType := f.issueTypes.find("name", ref["name"])
+			if ref["id"] != nil {
+				issueType = f.issueTypes[fmt.Sprintf("%v", ref["id"])]
+			}
+			if issueType == nil {
+				return fakeFieldError("issuetype", "valid issue type is required")
+			}
+			fields["issuetype"] = issueType
+		case "assignee", "reporter":
+			if v == nil {
+				fields[k] = nil
+				continue
+			}
+			name := reference(v)["name"]
+			user := f.users.find("name", name)
+			if user == nil {
+				return fakeFieldError(k, "User '%v' does not exist.", name)
+			}
+			fields[k] = user
+		case "security":
+			if v == nil {
+				fields[k] = nil
+				continue
+			}
+			_, level := f.findSecurityLevel(fmt.Sprintf("%v", reference(v)["id"]))
+			if level == nil {
+				return fakeFieldError(k, "Security level: Specify a valid value for security level.")
+			}
+			fields[k] = level
+		case "priority":
+			ref := reference(v)
+			priority := f.priorities.find("name", ref["name"])
+			if ref["id"] != nil {
+				priority = f.priorities[fmt.Sprintf("%v", ref["id"])]
+			}
+			if priority == nil {
+				return fakeFieldError(k, "The priority selected is invalid.")
+			}
+			fields[k] = priority
+		case "duedate":
+			if date, _ := v.(string); v != nil {
+				if _, err := time.Parse("2006-01-02", date); err != nil {
+					return fakeFieldError(k, "Error parsing date string: %v", v)
+				}
+			}
+			fields[k] = v
+		case "timetracking":
+			timetracking := fakeObject{}
+			for key, estimate := range reference(fields[k]) {
+				timetracking[key] = estimate
+			}
+			for _, key := range []string{"originalEstimate", "remainingEstimate"} {
+				estimate, ok := reference(v)[key].(string)
+				if !ok {
+					continue
+				}
+				if estimate == "" {
+					delete(timetracking, key)
+					delete(timetracking, key+"Seconds")
+					continue
+				}
+				normalized, err := fakeEstimate(estimate)
+				if err != nil {
+					return fakeFieldError(k, "The estimate specified is not valid.")
+				}
+				minutes, _ := fakeEstimateMinutes(estimate)
+				timetracking[key] = normalized
+				timetracking[key+"Seconds"] = int(minutes * 60)
+			}
+			// The remaining estimate of new issues defaults to the original estimate
+			if _, ok := timetracking["remainingEstimate"]; !ok && timetracking["originalEstimate"] != nil {
+				timetracking["remainingEstimate"] = timetracking["originalEstimate"]
+				timetracking["remainingEstimateSeconds"] = timetracking["originalEstimateSeconds"]
+			}
+			fields[k] = timetracking
+		case "parent":
+			if v == nil {
+				if reference(fields["issuetype"])["subtask"] == true {
+					return fakeFieldError(k, "Issue type is a sub-task but parent issue key or id not specified.")
+				}
+				fields[k] = nil
+				continue
+			}
+			parent, errs := f.findIssue(fmt.Sprintf("%v", reference(v)["key"]))
+			if errs != nil {
+				return fakeFieldError(k, "Could not find issue by id or key.")
+			}
+			fields[k] = fakeObject{"id": parent["id"], "key": parent["key"], "self": parent["self"]}
+		default:
+			if field := f.fields[k]; field["custom"] == true && v != nil {
+				value, errs := f.customFieldValue(field, v)
+				if errs != nil {
+					return errs
+				}
+				v = value
+			}
+			fields[k] = v
+		}
+	}
+
+	// The security level needs to belong to the scheme of the project
+	if level := reference(fields["security"]); level["id"] != nil {
+		scheme, _ := f.findSecurityLevel(level["id"].(string))
+		project := f.projects[fmt.Sprintf("%v", reference(fields["project"])["id"])]
+		if project == nil || project["issueSecurityScheme"] != scheme["id"] {
+			return fakeFieldError("security", "Security level: Specify a valid value for security level.")
+		}
+	}
+
+	// Components and versions are looked up by name in the project
+	if project := f.projects[fmt.Sprintf("%v", reference(fields["project"])["id"])]; project != nil {
+		for _, k := range []string{"components", "fixVersions", "versions"} {
+			refs, ok := update[k].([]interface{})
+			if !ok {
+				continue
+			}
+			values := []interface{}{}
+			for _, ref := range refs {
+				value := f.projectComponentOrVersion(project, k, reference(ref))
+				if value == nil {
+					return fakeFieldError(k, "%s name '%v' is not valid", map[string]string{"components": "Component", "fixVersions": "Version", "versions": "Version"}[k], reference(ref)["name"])
+				}
+				values = append(values, value)
+			}
+			fields[k] = values
+		}
+	}
+
+	// The issue type needs to be part of the issue type scheme of the project
+	if project := f.projects[fmt.Sprintf("%v", reference(fields["project"])["id"])]; project != nil {
+		scheme := f.projectIssueTypeScheme(project)
+		issueTypeID := fmt.Sprintf("%v", reference(fields["issuetype"])["id"])
+		if fields["issuetype"] != nil && !containsString(f.issueTypeSchemeTypes(scheme["id"].(string)), issueTypeID) {
+			return fakeFieldError("issuetype", "The issue type selected is invalid.")
+		}
+	}
+
+	if summary, _ := fields["summary"].(string); summary == "" {
+		return fakeFieldError("summary", "You must specify a summary of the issue.")
+	}
+
+	// Fields required by the field configuration of the issue type need a value
+	if project := f.projects[fmt.Sprintf("%v", reference(fields["project"])["id"])]; project != nil {
+		configurationID := f.projectFieldConfiguration(project, fmt.Sprintf("%v", reference(fields["issuetype"])["id"]))
+		for fieldID, item := range f.fieldConfigurationItems[configurationID] {
+			if item["isRequired"] != true {
+				continue
+			}
+			switch v := fields[fieldID].(type) {
+			case nil:
+			case string:
+				if v != "" {
+					continue
+				}
+			case []interface{}:
+				if len(v) > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+			return fakeFieldError(fieldID, "%v is required.", f.fields[fieldID]["name"])
+		}
+	}
+	return nil
+}
+
+// fieldOption returns the option of the field referenced by ID or value, as
+// returned in issues
+func (f *fakeJira) fieldOption(fieldID string, ref fakeObject, parentID interface{}) fakeObject {
+	for _, contextID := range sortedKeys(f.fieldContexts) {
+		if f.fieldContexts[contextID]["fieldId"] != fieldID {
+			continue
+		}
+		for _, option := range f.fieldOptions[contextID] {
+			if option["optionId"] != parentID {
+				continue
+			}
+			if (ref["id"] != nil && fmt.Sprintf("%v", ref["id"]) == option["id"]) || (ref["id"] == nil && ref["value"] == option["value"]) {
+				return fakeObject{
+					"self":  fmt.Sprintf("%s/rest/api/2/customFieldOption/%s", f.URL, option["id"]),
+					"value": option["value"],
+					"id":    option["id"],
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// customFieldValue converts the value of a custom field into the
+// representation returned in issues, resolving references to options and users
+func (f *fakeJira) customFieldValue(field fakeObject, v interface{}) (interface{}, fakeObject) {
+	id := fmt.Sprintf("%v", field["id"])
+	invalid := fakeFieldError(id, "Specify a valid value for %v", field["name"])
+
+	switch reference(field["schema"])["custom"] {
+	case "com.atlassian.jira.plugin.system.customfieldtypes:select", "com.atlassian.jira.plugin.system.customfieldtypes:radiobuttons":
+		option := f.fieldOption(id, reference(v), nil)
+		if option == nil {
+			return nil, invalid
+		}
+		return option, nil
+	case "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect":
+		option := f.fieldOption(id, reference(v), nil)
+		if option == nil {
+			return nil, invalid
+		}
+		if child := reference(reference(v)["child"]); child != nil {
+			childOption := f.fieldOption(id, child, option["id"])
+			if childOption == nil {
+				return nil, invalid
+			}
+			option["child"] = childOption
+		}
+		return option, nil
+	case "com.atlassian.jira.plugin.system.customfieldtypes:multiselect", "com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes":
+		values, ok := v.([]interface{})
+		if !ok {
+			return nil, invalid
+		}
+		options := []interface{}{}
+		for _, value := range values {
+			option := f.fieldOption(id, reference(value), nil)
+			if option == nil {
+				return nil, invalid
+			}
+			options = append(options, option)
+		}
+		return options, nil
+	case "com.atlassian.jira.plugin.system.customfieldtypes:userpicker":
+		user := f.users.find("name", reference(v)["name"])
+		if user == nil {
+			return nil, fakeFieldError(id, "User '%v' does not exist.", reference(v)["name"])
+		}
+		return user, nil
+	}
+	return v, nil
+}
+
+// findIssue looks up an issue by ID or key
+func (f *fakeJira) findIssue(idOrKey string) (fakeObject, fakeObject) {
+	issue := f.issues.lookup("key", idOrKey)
+	if issue == nil {
+		return nil, fakeError("Issue Does Not Exist")
+	}
+	return issue, nil
+}
+
+func (f *fakeJira) createIssue(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	fields := fakeObject{
+		"status":  f.statuses.find("name", "Open"),
+		"labels":  []interface{}{},
+		"comment": fakeObject{"comments": []interface{}{}},
+	}
+	for _, field := range []string{"description", "environment"} {
+		if errs := f.readRichTextField(r, reference(body["fields"]), field); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+	}
+	if errs := f.applyIssueFields(fields, reference(body["fields"])); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if _, ok := fields["project"]; !ok {
+		return http.StatusBadRequest, fakeFieldError("project", "valid project is required")
+	}
+	if _, ok := fields["issuetype"]; !ok {
+		return http.StatusBadRequest, fakeFieldError("issuetype", "valid issue type is required")
+	}
+
+	projectKey := reference(fields["project"])["key"].(string)
+	f.issueCounters[projectKey]++
+
+	// Issues without priority get the default priority of the priority scheme
+	if _, ok := fields["priority"]; !ok {
+		project := f.projects.find("key", projectKey)
+		scheme := f.prioritySchemes.find("defaultScheme", true)
+		if id, ok := project["priorityScheme"]; ok {
+			scheme = f.prioritySchemes[fmt.Sprintf("%v", id)]
+		}
+		fields["priority"] = f.priorities[fmt.Sprintf("%v", scheme["defaultOptionId"])]
+	}
+
+	// Issues without security level get the default level of the issue
+	// security scheme of the project
+	if _, ok := fields["security"]; !ok {
+		project := f.projects.find("key", projectKey)
+		if scheme := f.securitySchemes[fmt.Sprintf("%v", project["issueSecurityScheme"])]; scheme != nil {
+			if id, ok := scheme["defaultSecurityLevelId"]; ok {
+				_, fields["security"] = f.findSecurityLevel(fmt.Sprintf("%v", id))
+			}
+		}
+	}
+
+	issue := f.insert(issueAPIEndpoint, f.issues, false, fakeObject{
+		"key":    fmt.Sprintf("%s-%d", projectKey, f.issueCounters[projectKey]),
+		"fields": fields,
+	})
+
+	// Like JIRA with the default autowatch setting, the reporter watches new issues
+	f.issueWatchers[issue["id"].(string)] = []string{}
+	if reporter, ok := reference(fields["reporter"])["name"].(string); ok {
+		f.issueWatchers[issue["id"].(string)] = []string{reporter}
+	}
+
+	// Issues start in the status of the initial transition of their workflow
+	workflowTransitions, _ := f.issueWorkflow(issue)["transitions"].([]interface{})
+	for _, t := range workflowTransitions {
+		if transition := reference(t); transition["type"] == "initial" {
+			fields["status"] = f.statuses[fmt.Sprintf("%v", transition["to"])]
+		}
+	}
+
+	return http.StatusCreated, fakeObject{"id": issue["id"], "key": issue["key"], "self": issue["self"]}
+}
+
+func (f *fakeJira) getIssue(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	return http.StatusOK, f.issueView(r, issue)
+}
+
+func (f *fakeJira) updateIssue(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	update := reference(body["fields"])
+	fields := reference(issue["fields"])
+	if project, ok := update["project"]; ok && reference(project)["key"] != reference(fields["project"])["key"] {
+		return http.StatusBadRequest, fakeFieldError("project", "Field 'project' cannot be set. It is not on the appropriate screen, or unknown.")
+	}
+	for _, field := range []string{"description", "environment"} {
+		if errs := f.readRichTextField(r, update, field); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+	}
+
+	if errs := f.applyIssueFields(fields, update); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteIssue(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	for id, link := range f.issueLinks {
+		if reference(link["inwardIssue"])["id"] == issue["id"] || reference(link["outwardIssue"])["id"] == issue["id"] {
+			delete(f.issueLinks, id)
+		}
+	}
+	for id, attachment := range f.attachments {
+		if attachment["issueId"] == issue["id"] {
+			delete(f.attachments, id)
+		}
+	}
+	delete(f.issueWatchers, issue["id"].(string))
+	delete(f.issues, issue["id"].(string))
+
+	return http.StatusNoContent, nil
+}
+
+// issueWorkflow returns the workflow of the issue, given by the workflow
+// scheme of its project
+func (f *fakeJira) issueWorkflow(issue fakeObject) fakeObject {
+	fields := reference(issue["fields"])
+	project := f.projects[fmt.Sprintf("%v", reference(fields["project"])["id"])]
+
+	var scheme fakeObject
+	if id, ok := project["workflowScheme"]; ok {
+		scheme = f.workflowSchemes[fmt.Sprintf("%v", id)]
+	}
+	return f.workflowOf(scheme, fmt.Sprintf("%v", reference(fields["issuetype"])["id"]))
+}
+
+// availableTransitions returns the transitions of the workflow of the issue
+// which start from its status. Transitions with a screen ask for a resolution.
+func (f *fakeJira) availableTransitions(issue fakeObject) []fakeObject {
+	statusID := fmt.Sprintf("%v", reference(reference(issue["fields"])["status"])["id"])
+
+	available := []fakeObject{}
+	workflowTransitions, _ := f.issueWorkflow(issue)["transitions"].([]interface{})
+	for _, t := range workflowTransitions {
+		transition := reference(t)
+		if transition["type"] == "initial" {
+			continue
+		}
+		if from := fakeStrings(transition["from"]); len(from) > 0 && !containsString(from, statusID) {
+			continue
+		}
+
+		fields := fakeObject{}
+		if transition["screen"] != nil {
+			fields["resolution"] = fakeObject{"required": true, "name": "Resolution"}
+		}
+		available = append(available, fakeObject{
+			"id":     transition["id"],
+			"name":   transition["name"],
+			"to":     f.statuses[fmt.Sprintf("%v", transition["to"])],
+			"fields": fields,
+		})
+	}
+	return available
+}
+
+func (f *fakeJira) getTransitions(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	return http.StatusOK, fakeObject{"transitions": f.availableTransitions(issue)}
+}
+
+func (f *fakeJira) doTransition(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	id := reference(body["transition"])["id"]
+	for _, transition := range f.availableTransitions(issue) {
+		if transition["id"] != id {
+			continue
+		}
+
+		fields := reference(issue["fields"])
+		values := reference(body["fields"])
+		for field := range values {
+			if _, ok := reference(transition["fields"])[field]; !ok {
+				return http.StatusBadRequest, fakeFieldError(field, "Field '%s' cannot be set. It is not on the appropriate screen, or unknown.", field)
+			}
+		}
+		if _, ok := reference(transition["fields"])["resolution"]; ok {
+			resolution := f.resolutions.find("name", reference(values["resolution"])["name"])
+			if resolution == nil {
+				return http.StatusBadRequest, fakeFieldError("resolution", "Resolution is required.")
+			}
+			fields["resolution"] = resolution
+		}
+
+		updates, _ := reference(body["update"])["comment"].([]interface{})
+		for _, update := range updates {
+			container := comments(issue)
+			commentID := strconv.Itoa(f.nextID())
+			container["comments"] = append(container["comments"].([]interface{}), fakeObject{
+				"id":   commentID,
+				"self": fmt.Sprintf("%s/comment/%s", issue["self"], commentID),
+				"body": reference(reference(update)["add"])["body"],
+			})
+		}
+
+		fields["status"] = transition["to"]
+		return http.StatusNoContent, nil
+	}
+	return http.StatusBadRequest, fakeError("Transition id '%v' is not valid for this issue.", id)
+}
+
+// projectComponentOrVersion looks up a component or version of the project by
+// name or ID
+func (f *fakeJira) projectComponentOrVersion(project fakeObject, field string, ref fakeObject) fakeObject {
+	collection := f.versions
+	if field == "components" {
+		collection = f.components
+	}
+	for _, id := range sortedKeys(collection) {
+		obj := collection[id]
+		if obj["project"] != project["key"] && fmt.Sprintf("%v", obj["projectId"]) != project["id"] {
+			continue
+		}
+		if obj["name"] == ref["name"] || obj["id"] == ref["id"] {
+			return fakeObject{"id": obj["id"], "name": obj["name"], "self": obj["self"]}
+		}
+	}
+	return nil
+}
+
+var fakeEstimateUnit = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([wdhm])`)
+
+// fakeEstimateMinutes converts an estimate like 1w 2d 4h 30m into minutes,
+// using the JIRA defaults of 5 working days per week and 8 working hours per
+// day
+func fakeEstimateMinutes(estimate string) (float64, error) {
+	matches := fakeEstimateUnit.FindAllStringSubmatch(estimate, -1)
+	if len(matches) == 0 || strings.TrimSpace(fakeEstimateUnit.ReplaceAllString(estimate, "")) != "" {
+		return 0, fmt.Errorf("%q is not an estimate like 1w 2d 4h 30m", estimate)
+	}
+
+	factors := map[string]float64{"w": 5 * 8 * 60, "d": 8 * 60, "h": 60, "m": 1}
+	minutes := 0.0
+	for _, m := range matches {
+		value, _ := strconv.ParseFloat(m[1], 64)
+		minutes += value * factors[m[2]]
+	}
+	return minutes, nil
+}
+
+// fakeEstimate normalizes estimates like JIRA, e.g. 90m into 1h 30m
+func fakeEstimate(estimate string) (string, error) {
+	minutes, err := fakeEstimateMinutes(estimate)
+	if err != nil {
+		return "", err
+	}
+	parts := []string{}
+	remaining := int(minutes)
+	for _, unit := range []struct {
+		name    string
+		minutes int
+	}{{"w", 5 * 8 * 60}, {"d", 8 * 60}, {"h", 60}, {"m", 1}} {
+		if n := remaining / unit.minutes; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, unit.name))
+			remaining -= n * unit.minutes
+		}
+	}
+	if len(parts) == 0 {
+		return "0m", nil
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// addAttachments stores the files of a multipart upload. Like JIRA, uploads
+// without the header disabling the XSRF check are rejected.
+func (f *fakeJira) addAttachments(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	if token := r.Header.Get("X-Atlassian-Token"); token != "no-check" && token != "nocheck" {
+		return http.StatusForbidden, fakeError("XSRF check failed")
+	}
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		return http.StatusBadRequest, fakeError("Unexpected request body: %s", err)
+	}
+	files := r.MultipartForm.File["file"]
+	if len(files) == 0 {
+		return http.StatusBadRequest, fakeError("No attachments were found in the request")
+	}
+
+	created := []interface{}{}
+	for _, header := range files {
+		file, err := header.Open()
+		if err != nil {
+			return http.StatusBadRequest, fakeError("Unexpected request body: %s", err)
+		}
+		content, err := ioutil.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return http.StatusBadRequest, fakeError("Unexpected request body: %s", err)
+		}
+
+		attachment := f.insert(attachmentAPIEndpoint, f.attachments, false, fakeObject{
+			"filename": header.Filename,
+			"size":     len(content),
+			"mimeType": strings.Split(http.DetectContentType(content), ";")[0],
+			"issueId":  issue["id"],
+		})
+		attachment["content"] = fmt.Sprintf("%s/secure/attachment/%s/%s", f.URL, attachment["id"], header.Filename)
+		created = append(created, attachment)
+	}
+	return http.StatusOK, created
+}
+
+func (f *fakeJira) getWatchers(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	watchers := []interface{}{}
+	for _, name := range f.issueWatchers[issue["id"].(string)] {
+		if user := f.users.find("name", name); user != nil {
+			watchers = append(watchers, user)
+		}
+	}
+	return http.StatusOK, fakeObject{
+		"watchCount": len(watchers),
+		"isWatching": false,
+		"watchers":   watchers,
+	}
+}
+
+// addWatcher adds the user given as JSON string. Adding a user who already
+// watches the issue succeeds without changes.
+func (f *fakeJira) addWatcher(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	var username string
+	if err := json.NewDecoder(r.Body).Decode(&username); err != nil {
+		return http.StatusBadRequest, fakeError("Unexpected request body: %s", err)
+	}
+	user := f.users.findFold("name", username)
+	if user == nil {
+		return http.StatusBadRequest, fakeError("The user \"%s\" does not have permission to view this issue. This user will not be added to the watch list.", username)
+	}
+
+	id, name := issue["id"].(string), user["name"].(string)
+	f.issueWatchers[id] = append(without(f.issueWatchers[id], name), name)
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) removeWatcher(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	user := f.findUser(r)
+	if user == nil {
+		return http.StatusNotFound, fakeError("The user %s does not exist.", r.URL.RawQuery)
+	}
+
+	id := issue["id"].(string)
+	f.issueWatchers[id] = without(f.issueWatchers[id], user["name"].(string))
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getAttachment(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	attachment, ok := f.attachments[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The attachment with id '%s' does not exist", params[0])
+	}
+	return http.StatusOK, attachment
+}
+
+func (f *fakeJira) deleteAttachment(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.attachments[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The attachment with id '%s' does not exist", params[0])
+	}
+	delete(f.attachments, params[0])
+	return http.StatusNoContent, nil
+}
+
+// fakeV3 reports whether the request uses version 3 of the API
+func fakeV3(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/rest/api/3/")
+}
+
+// readRichTextField validates the rich text of a request: version 3 of the
+// API expects documents, version 2 wiki markup. Like JIRA, a local ID is
+// added to the top level nodes of documents.
+func (f *fakeJira) readRichTextField(r *http.Request, values fakeObject, field string) fakeObject {
+	value, ok := values[field]
+	if !ok || value == nil {
+		return nil
+	}
+	if !fakeV3(r) {
+		if _, ok := value.(string); !ok {
+			return fakeFieldError(field, "Operation value must be a string")
+		}
+		return nil
+	}
+
+	document := reference(value)
+	if document["type"] != "doc" {
+		return fakeFieldError(field, "Operation value must be an Atlassian Document (see the Atlassian Document Format)")
+	}
+	content, _ := document["content"].([]interface{})
+	for _, node := range content {
+		block := reference(node)
+		attrs := reference(block["attrs"])
+		if attrs == nil {
+			attrs = fakeObject{}
+		}
+		attrs["localId"] = fmt.Sprintf("local-%d", f.nextID())
+		block["attrs"] = attrs
+	}
+	return nil
+}
+
+// fakeRichText returns rich text in the format of the API version of the
+// request. Version 2 renders documents as plain text, version 3 wraps wiki
+// markup into a document.
+func fakeRichText(r *http.Request, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	text, isText := value.(string)
+	if fakeV3(r) && isText {
+		return fakeObject{
+			"type":    "doc",
+			"version": 1,
+			"content": []interface{}{fakeObject{
+				"type":    "paragraph",
+				"content": []interface{}{fakeObject{"type": "text", "text": text}},
+			}},
+		}
+	}
+	if !fakeV3(r) && !isText {
+		return fakeDocumentText(value)
+	}
+	return value
+}
+
+// fakeDocumentText concatenates the text nodes of a document
+func fakeDocumentText(node interface{}) string {
+	n := reference(node)
+	if text, ok := n["text"].(string); ok {
+		return text
+	}
+	parts := []string{}
+	content, _ := n["content"].([]interface{})
+	for _, child := range content {
+		parts = append(parts, fakeDocumentText(child))
+	}
+	if n["type"] == "paragraph" || n["type"] == "heading" {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts, "\n")
+}
+
+// issueView returns the issue with its rich text in the format of the API
+// version of the request
+func (f *fakeJira) issueView(r *http.Request, issue fakeObject) fakeObject {
+	fields := fakeObject{}
+	for k, v := range reference(issue["fields"]) {
+		fields[k] = v
+	}
+	for _, field := range []string{"description", "environment"} {
+		if value, ok := fields[field]; ok {
+			fields[field] = fakeRichText(r, value)
+		}
+	}
+	list := []interface{}{}
+	for _, comment := range comments(issue)["comments"].([]interface{}) {
+		list = append(list, commentView(r, reference(comment)))
+	}
+	fields["comment"] = fakeObject{"comments": list}
+
+	attachments := []interface{}{}
+	for _, id := range sortedKeys(f.attachments) {
+		if f.attachments[id]["issueId"] == issue["id"] {
+			attachments = append(attachments, f.attachments[id])
+		}
+	}
+	fields["attachment"] = attachments
+
+	view := fakeObject{}
+	for k, v := range issue {
+		view[k] = v
+	}
+	view["fields"] = fields
+	return view
+}
+
+func commentView(r *http.Request, comment fakeObject) fakeObject {
+	view := fakeObject{}
+	for k, v := range comment {
+		view[k] = v
+	}
+	view["body"] = fakeRichText(r, comment["body"])
+	return view
+}
+
+// comments returns the comment container of an issue
+func comments(issue fakeObject) fakeObject {
+	return reference(reference(issue["fields"])["comment"])
+}
+
+func (f *fakeJira) addComment(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if text, _ := body["body"].(string); body["body"] == nil || (text == "" && !fakeV3(r)) {
+		return http.StatusBadRequest, fakeFieldError("body", "Comment body can not be empty!")
+	}
+	if errs := f.readRichTextField(r, body, "body"); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	id := strconv.Itoa(f.nextID())
+	comment := fakeObject{
+		"id":   id,
+		"self": fmt.Sprintf("%s/comment/%s", issue["self"], id),
+		"body": body["body"],
+	}
+
+	container := comments(issue)
+	container["comments"] = append(container["comments"].([]interface{}), comment)
+
+	return http.StatusCreated, commentView(r, comment)
+}
+
+func (f *fakeJira) findComment(params []string) (fakeObject, int, fakeObject) {
+	issue, errs := f.findIssue(params[0])
+	if errs != nil {
+		return nil, -1, errs
+	}
+
+	for i, c := range comments(issue)["comments"].([]interface{}) {
+		if reference(c)["id"] == params[1] {
+			return issue, i, nil
+		}
+	}
+	return issue, -1, fakeError("Can not find a comment for the id: %s.", params[1])
+}
+
+func (f *fakeJira) updateComment(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, i, errs := f.findComment(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if errs := f.readRichTextField(r, body, "body"); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	comment := reference(comments(issue)["comments"].([]interface{})[i])
+	comment["body"] = body["body"]
+
+	return http.StatusOK, commentView(r, comment)
+}
+
+func (f *fakeJira) getComment(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, i, errs := f.findComment(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	return http.StatusOK, commentView(r, reference(comments(issue)["comments"].([]interface{})[i]))
+}
+
+func (f *fakeJira) deleteComment(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issue, i, errs := f.findComment(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	container := comments(issue)
+	list := container["comments"].([]interface{})
+	container["comments"] = append(list[:i], list[i+1:]...)
+
+	return http.StatusNoContent, nil
+}
+
+var fakeJQLClause = regexp.MustCompile(`^\s*(\w+)\s*=\s*"?([^"]*?)"?\s*$`)
+
+// search supports JQL queries consisting of equality clauses on project, key
+// and issuetype combined with AND
+func (f *fakeJira) search(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	jql := regexp.MustCompile(`(?i)\s+order\s+by\s+.*$`).ReplaceAllString(r.URL.Query().Get("jql"), "")
+
+	matches := []fakeObject{}
+	for _, id := range sortedKeys(f.issues) {
+		issue := f.issues[id]
+		fields := reference(issue["fields"])
+		match := true
+
+		for _, clause := range regexp.MustCompile(`(?i)\s+and\s+`).Split(jql, -1) {
+			if strings.TrimSpace(clause) == "" {
+				continue
+			}
+
+			m := fakeJQLClause.FindStringSubmatch(clause)
+			if m == nil {
+				return http.StatusBadRequest, fakeError("Error in the JQL Query: unsupported clause '%s'.", clause)
+			}
+
+			var value interface{}
+			switch strings.ToLower(m[1]) {
+			case "project":
+				value = reference(fields["project"])["key"]
+			case "key":
+				value = issue["key"]
+			case "issuetype":
+				value = reference(fields["issuetype"])["name"]
+			default:
+				return http.StatusBadRequest, fakeError("Field '%s' does not exist or you do not have permission to view it.", m[1])
+			}
+			match = match && value == m[2]
+		}
+
+		if match {
+			matches = append(matches, issue)
+		}
+	}
+
+	return http.StatusOK, fakeObject{
+		"startAt":    0,
+		"maxResults": len(matches),
+		"total":      len(matches),
+		"issues":     matches,
+	}
+}
+
+func (f *fakeJira) getFields(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	fields := []fakeObject{}
+	for _, id := range sortedKeys(f.fields) {
+		fields = append(fields, f.fields[id])
+	}
+	return http.StatusOK, fields
+}
+
+// fakeCustomFieldTypes maps the supported custom field types to the type of their values
+var fakeCustomFieldTypes = map[string]string{
+	"com.atlassian.jira.plugin.system.customfieldtypes:textfield":       "string",
+	"com.atlassian.jira.plugin.system.customfieldtypes:textarea":        "string",
+	"com.atlassian.jira.plugin.system.customfieldtypes:float":           "number",
+	"com.atlassian.jira.plugin.system.customfieldtypes:datepicker":      "date",
+	"com.atlassian.jira.plugin.system.customfieldtypes:userpicker":      "user",
+	"com.atlassian.jira.plugin.system.customfieldtypes:url":             "string",
+	"com.atlassian.jira.plugin.system.customfieldtypes:select":          "option",
+	"com.atlassian.jira.plugin.system.customfieldtypes:radiobuttons":    "option",
+	"com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect": "option-with-child",
+	"com.atlassian.jira.plugin.system.customfieldtypes:multiselect":     "array",
+	"com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes": "array",
+	"com.atlassian.jira.plugin.system.customfieldtypes:labels":          "array",
+}
+
+func (f *fakeJira) createField(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The custom field name must be specified.")
+	}
+
+	fieldType, _ := body["type"].(string)
+	schemaType, ok := fakeCustomFieldTypes[fieldType]
+	if !ok {
+		return http.StatusBadRequest, fakeFieldError("type", "Unknown custom field type %q.", fieldType)
+	}
+
+	customID := f.nextID()
+	id := fmt.Sprintf("customfield_%d", customID)
+	field := fakeObject{
+		"id":          id,
+		"key":         id,
+		"name":        name,
+		"description": body["description"],
+		"searcherKey": body["searcherKey"],
+		"custom":      true,
+		"navigable":   true,
+		"searchable":  true,
+		"clauseNames": []string{fmt.Sprintf("cf[%d]", customID), name},
+		"schema":      fakeObject{"type": schemaType, "custom": fieldType, "customId": customID},
+	}
+	f.fields[id] = field
+
+	return http.StatusCreated, field
+}
+
+func (f *fakeJira) updateField(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	field, ok := f.fields[params[0]]
+	if !ok || field["custom"] != true {
+		return http.StatusNotFound, fakeError("The custom field %s was not found.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	for _, attribute := range []string{"name", "description", "searcherKey"} {
+		if v, ok := body[attribute]; ok {
+			field[attribute] = v
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteField(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	field, ok := f.fields[params[0]]
+	if !ok || field["custom"] != true {
+		return http.StatusNotFound, fakeError("The custom field %s was not found.", params[0])
+	}
+
+	delete(f.fields, params[0])
+	for id, fieldContext := range f.fieldContexts {
+		if fieldContext["fieldId"] == params[0] {
+			f.removeFieldContext(id)
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+// fakePage wraps values into a single page of a paginated response
+func fakePage(values []fakeObject) fakeObject {
+	return fakeObject{
+		"startAt":    0,
+		"maxResults": len(values),
+		"total":      len(values),
+		"isLast":     true,
+		"values":     values,
+	}
+}
+
+// fakeStrings converts a decoded JSON array to strings
+func fakeStrings(v interface{}) []string {
+	list, _ := v.([]interface{})
+	result := []string{}
+	for _, item := range list {
+		result = append(result, fmt.Sprint(item))
+	}
+	return result
+}
+
+// fieldContextsOf returns the contexts of the field in params[0], which match the
+// contextId query parameter if it is set
+func (f *fakeJira) fieldContextsOf(r *http.Request, params []string) ([]fakeObject, fakeObject) {
+	if _, ok := f.fields[params[0]]; !ok {
+		return nil, fakeError("The custom field was not found.")
+	}
+
+	contextIDs := r.URL.Query()["contextId"]
+	contexts := []fakeObject{}
+	for _, id := range sortedKeys(f.fieldContexts) {
+		fieldContext := f.fieldContexts[id]
+		if fieldContext["fieldId"] != params[0] {
+			continue
+		}
+		if len(contextIDs) > 0 && !containsString(contextIDs, id) {
+			continue
+		}
+		contexts = append(contexts, fieldContext)
+	}
+	return contexts, nil
+}
+
+// fieldContext returns the context identified by the field in params[0] and
+// the context in params[1]
+func (f *fakeJira) fieldContext(params []string) (fakeObject, fakeObject) {
+	fieldContext, ok := f.fieldContexts[params[1]]
+	if !ok || fieldContext["fieldId"] != params[0] {
+		return nil, fakeError("The context was not found.")
+	}
+	return fieldContext, nil
+}
+
+func (f *fakeJira) getFieldContexts(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	contexts, errs := f.fieldContextsOf(r, params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	values := []fakeObject{}
+	for _, fieldContext := range contexts {
+		values = append(values, fakeObject{
+			"id":              fieldContext["id"],
+			"name":            fieldContext["name"],
+			"description":     fieldContext["description"],
+			"isGlobalContext": len(fieldContext["projectIds"].([]string)) == 0,
+			"isAnyIssueType":  len(fieldContext["issueTypeIds"].([]string)) == 0,
+		})
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) createFieldContext(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.fields[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The custom field was not found.")
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The context name must be specified.")
+	}
+
+	id := strconv.Itoa(f.nextID())
+	f.fieldContexts[id] = fakeObject{
+		"id":           id,
+		"fieldId":      params[0],
+		"name":         body["name"],
+		"description":  body["description"],
+		"projectIds":   fakeStrings(body["projectIds"]),
+		"issueTypeIds": fakeStrings(body["issueTypeIds"]),
+	}
+
+	return http.StatusCreated, fakeObject{
+		"id":           id,
+		"name":         body["name"],
+		"description":  body["description"],
+		"projectIds":   fakeStrings(body["projectIds"]),
+		"issueTypeIds": fakeStrings(body["issueTypeIds"]),
+	}
+}
+
+func (f *fakeJira) getFieldContextMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	contexts, errs := f.fieldContextsOf(r, params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	attribute, key, anyKey := "projectIds", "projectId", "isGlobalContext"
+	if params[1] == "issuetypemapping" {
+		attribute, key, anyKey = "issueTypeIds", "issueTypeId", "isAnyIssueType"
+	}
+
+	values := []fakeObject{}
+	for _, fieldContext := range contexts {
+		ids := fieldContext[attribute].([]string)
+		if len(ids) == 0 {
+			values = append(values, fakeObject{"contextId": fieldContext["id"], anyKey: true})
+		}
+		for _, id := range ids {
+			values = append(values, fakeObject{"contextId": fieldContext["id"], key: id})
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateFieldContext(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	fieldContext, errs := f.fieldContext(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	for _, attribute := range []string{"name", "description"} {
+		if v, ok := body[attribute]; ok {
+			fieldContext[attribute] = v
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) removeFieldContext(id string) {
+	delete(f.fieldContexts, id)
+	delete(f.fieldOptions, id)
+	delete(f.fieldDefaults, id)
+}
+
+func (f *fakeJira) deleteFieldContext(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.fieldContext(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	f.removeFieldContext(params[1])
+	return http.StatusNoContent, nil
+}
+
+// fieldContextMapping returns the attribute and the request key of the
+// mapping in params[2]
+func fieldContextMapping(params []string) (string, string) {
+	if params[2] == "issuetype" {
+		return "issueTypeIds", "issueTypeIds"
+	}
+	return "projectIds", "projectIds"
+}
+
+func (f *fakeJira) addFieldContextMapping(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	fieldContext, errs := f.fieldContext(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	attribute, key := fieldContextMapping(params)
+	ids := fieldContext[attribute].([]string)
+	if len(ids) == 0 && attribute == "projectIds" {
+		return http.StatusBadRequest, fakeError("Projects cannot be added to a global context.")
+	}
+	for _, id := range fakeStrings(body[key]) {
+		if !containsString(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	fieldContext[attribute] = ids
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) removeFieldContextMapping(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	fieldContext, errs := f.fieldContext(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	attribute, key := fieldContextMapping(params)
+	ids := fieldContext[attribute].([]string)
+	for _, id := range fakeStrings(body[key]) {
+		if !containsString(ids, id) {
+			return http.StatusBadRequest, fakeError("%s is not mapped to the context.", id)
+		}
+		ids = without(ids, id)
+	}
+	if len(ids) == 0 {
+		return http.StatusBadRequest, fakeError("The last mapping of a context cannot be removed.")
+	}
+	fieldContext[attribute] = ids
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getFieldContextDefaults(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	contexts, errs := f.fieldContextsOf(r, params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	values := []fakeObject{}
+	for _, fieldContext := range contexts {
+		if defaultValue, ok := f.fieldDefaults[fieldContext["id"].(string)]; ok {
+			values = append(values, defaultValue)
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) setFieldContextDefaults(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	defaultValues, _ := body["defaultValues"].([]interface{})
+	for _, v := range defaultValues {
+		defaultValue := reference(v)
+		contextID, _ := defaultValue["contextId"].(string)
+		if _, errs := f.fieldContext([]string{params[0], contextID}); errs != nil {
+			return http.StatusNotFound, errs
+		}
+
+		// A default without value removes the default
+		if len(defaultValue) <= 2 {
+			delete(f.fieldDefaults, contextID)
+		} else {
+			f.fieldDefaults[contextID] = defaultValue
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getFieldOptions(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.fieldContext(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	values := []fakeObject{}
+	values = append(values, f.fieldOptions[params[1]]...)
+	return http.StatusOK, fakePage(values)
+}
+
+// findFieldOption returns the index of the option in the options of the context
+func (f *fakeJira) findFieldOption(contextID string, optionID string) int {
+	for i, option := range f.fieldOptions[contextID] {
+		if option["id"] == optionID {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateFieldOption rejects options whose value is not unique among their siblings
+func (f *fakeJira) validateFieldOption(contextID string, option fakeObject) fakeObject {
+	value, _ := option["value"].(string)
+	if value == "" {
+		return fakeFieldError("value", "The option value must be specified.")
+	}
+
+	for _, other := range f.fieldOptions[contextID] {
+		if other["id"] != option["id"] && other["optionId"] == option["optionId"] && other["value"] == value {
+			return fakeFieldError("value", "The option %q already exists.", value)
+		}
+	}
+	return nil
+}
+
+func (f *fakeJira) createFieldOptions(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.fieldContext(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	options, _ := body["options"].([]interface{})
+	created := []fakeObject{}
+	for _, v := range options {
+		o := reference(v)
+		option := fakeObject{
+			"id":       strconv.Itoa(f.nextID()),
+			"value":    o["value"],
+			"disabled": o["disabled"] == true,
+		}
+		if parentID, ok := o["optionId"].(string); ok {
+			if f.findFieldOption(params[1], parentID) < 0 {
+				return http.StatusBadRequest, fakeFieldError("optionId", "The parent option %s was not found.", parentID)
+			}
+			option["optionId"] = parentID
+		}
+		if errs := f.validateFieldOption(params[1], option); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+
+		f.fieldOptions[params[1]] = append(f.fieldOptions[params[1]], option)
+		created = append(created, option)
+	}
+
+	return http.StatusOK, fakeObject{"options": created}
+}
+
+func (f *fakeJira) updateFieldOptions(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.fieldContext(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	options, _ := body["options"].([]interface{})
+	updated := []fakeObject{}
+	for _, v := range options {
+		o := reference(v)
+		id, _ := o["id"].(string)
+		i := f.findFieldOption(params[1], id)
+		if i < 0 {
+			return http.StatusNotFound, fakeError("The option %s was not found.", id)
+		}
+
+		option := f.fieldOptions[params[1]][i]
+		if errs := f.validateFieldOption(params[1], fakeObject{"id": id, "value": o["value"], "optionId": option["optionId"]}); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+		option["value"] = o["value"]
+		option["disabled"] = o["disabled"] == true
+		updated = append(updated, option)
+	}
+
+	return http.StatusOK, fakeObject{"options": updated}
+}
+
+func (f *fakeJira) moveFieldOptions(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.fieldContext(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	var moved, rest []fakeObject
+	for _, id := range fakeStrings(body["customFieldOptionIds"]) {
+		i := f.findFieldOption(params[1], id)
+		if i < 0 {
+			return http.StatusBadRequest, fakeError("The option %s was not found.", id)
+		}
+		moved = append(moved, f.fieldOptions[params[1]][i])
+	}
+	for _, option := range f.fieldOptions[params[1]] {
+		if !containsString(fakeStrings(body["customFieldOptionIds"]), option["id"].(string)) {
+			rest = append(rest, option)
+		}
+	}
+
+	switch body["position"] {
+	case "First":
+		f.fieldOptions[params[1]] = append(moved, rest...)
+	case "Last":
+		f.fieldOptions[params[1]] = append(rest, moved...)
+	default:
+		return http.StatusBadRequest, fakeFieldError("position", "Only First and Last are supported.")
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteFieldOption(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.fieldContext(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+	if f.findFieldOption(params[1], params[2]) < 0 {
+		return http.StatusNotFound, fakeError("The option %s was not found.", params[2])
+	}
+
+	// Child options are removed along with their parent
+	options := []fakeObject{}
+	for _, option := range f.fieldOptions[params[1]] {
+		if option["id"] != params[2] && option["optionId"] != params[2] {
+			options = append(options, option)
+		}
+	}
+	f.fieldOptions[params[1]] = options
+
+	if defaultValue, ok := f.fieldDefaults[params[1]]; ok {
+		if defaultValue["optionId"] == params[2] || defaultValue["cascadingOptionId"] == params[2] {
+			delete(f.fieldDefaults, params[1])
+		} else if ids, ok := defaultValue["optionIds"]; ok {
+			defaultValue["optionIds"] = without(fakeStrings(ids), params[2])
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) createIssueLink(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	ref := reference(body["type"])
+	linkType := f.issueLinkTypes.find("name", ref["name"])
+	if ref["id"] != nil {
+		linkType = f.issueLinkTypes[fmt.Sprintf("%v", ref["id"])]
+	}
+	if linkType == nil {
+		return http.StatusNotFound, fakeError("No issue link type with name '%v' found.", ref["name"])
+	}
+
+	link := fakeObject{"type": linkType}
+	for _, side := range []string{"inwardIssue", "outwardIssue"} {
+		issue, errs := f.findIssue(fmt.Sprintf("%v", reference(body[side])["key"]))
+		if errs != nil {
+			return http.StatusNotFound, errs
+		}
+		link[side] = fakeObject{"id": issue["id"], "key": issue["key"], "self": issue["self"]}
+	}
+	f.insert(issueLinkAPIEndpoint, f.issueLinks, false, link)
+
+	w.Header().Set("Location", link["self"].(string))
+	return http.StatusCreated, nil
+}
+
+func (f *fakeJira) getIssueLink(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	link, ok := f.issueLinks[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("No issue link with id '%s' exists.", params[0])
+	}
+	return http.StatusOK, link
+}
+
+func (f *fakeJira) deleteIssueLink(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.issueLinks[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("No issue link with id '%s' exists.", params[0])
+	}
+	delete(f.issueLinks, params[0])
+	return http.StatusNoContent, nil
+}
+
+func sortedKeys(c fakeCollection) []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func without(list []string, value string) []string {
+	result := []string{}
+	for _, v := range list {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// startTask creates a completed task and redirects to it, like Jira does for
+// long running operations
+func (f *fakeJira) startTask(w http.ResponseWriter) (int, interface{}) {
+	task := f.insert(taskAPIEndpoint, f.tasks, false, fakeObject{"status": "COMPLETE", "progress": 100})
+	w.Header().Set("Location", task["self"].(string))
+	return http.StatusSeeOther, nil
+}
+
+func (f *fakeJira) getTask(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	task, ok := f.tasks[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The task %s does not exist.", params[0])
+	}
+	return http.StatusOK, task
+}
+
+// fakeConditionTree converts the conditions of a create workflow request into
+// the condition tree returned by Jira
+func fakeConditionTree(v interface{}) fakeObject {
+	condition := reference(v)
+	if conditions, ok := condition["conditions"].([]interface{}); ok {
+		nodes := []interface{}{}
+		for _, c := range conditions {
+			nodes = append(nodes, fakeConditionTree(c))
+		}
+		return fakeObject{"nodeType": "compound", "operator": condition["operator"], "conditions": nodes}
+	}
+	return fakeObject{"nodeType": "simple", "type": condition["type"], "configuration": condition["configuration"]}
+}
+
+// fakeWorkflowPostFunctions returns the types of the essential post functions
+// Jira adds to transitions of the given type
+func fakeWorkflowPostFunctions(transitionType interface{}) []string {
+	if transitionType == "initial" {
+		return []string{"IssueCreateFunction", "IssueReindexFunction", "FireIssueEventFunction"}
+	}
+	return []string{"UpdateIssueStatusFunction", "GenerateChangeHistoryFunction", "IssueReindexFunction", "FireIssueEventFunction"}
+}
+
+// workflowHasStatus reports whether the status is part of the workflow
+func workflowHasStatus(workflow fakeObject, statusID string) bool {
+	statuses, _ := workflow["statuses"].([]interface{})
+	for _, status := range statuses {
+		if reference(status)["id"] == statusID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeJira) createWorkflow(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The workflow name must be specified.")
+	}
+	if _, ok := f.workflows[name]; ok {
+		return http.StatusBadRequest, fakeFieldError("name", "A workflow with this name already exists.")
+	}
+
+	statuses, _ := body["statuses"].([]interface{})
+	if len(statuses) == 0 {
+		return http.StatusBadRequest, fakeFieldError("statuses", "The workflow must contain at least one status.")
+	}
+	for _, status := range statuses {
+		if _, ok := f.statuses[fmt.Sprintf("%v", reference(status)["id"])]; !ok {
+			return http.StatusBadRequest, fakeFieldError("statuses", "The status %v does not exist.", reference(status)["id"])
+		}
+	}
+
+	workflow := fakeObject{"description": body["description"], "statuses": statuses}
+	transitions, _ := body["transitions"].([]interface{})
+	initial := 0
+	for i, t := range transitions {
+		transition := reference(t)
+		if transitionName, _ := transition["name"].(string); transitionName == "" {
+			return http.StatusBadRequest, fakeFieldError("transitions", "The transition name must be specified.")
+		}
+
+		from, _ := transition["from"].([]interface{})
+		switch transition["type"] {
+		case "initial":
+			initial++
+		case "global":
+		case "directed":
+			if len(from) == 0 {
+				return http.StatusBadRequest, fakeFieldError("transitions", "The directed transition %v must start from a status.", transition["name"])
+			}
+		default:
+			return http.StatusBadRequest, fakeFieldError("transitions", "Invalid transition type %v.", transition["type"])
+		}
+
+		for _, statusID := range append(fakeStrings(from), fmt.Sprintf("%v", transition["to"])) {
+			if !workflowHasStatus(workflow, statusID) {
+				return http.StatusBadRequest, fakeFieldError("transitions", "The status %s of transition %v is not part of the workflow.", statusID, transition["name"])
+			}
+		}
+
+		transition["id"] = strconv.Itoa(i*10 + 1)
+		transition["from"] = fakeStrings(from)
+		rules := reference(transition["rules"])
+		if rules["conditions"] != nil {
+			rules["conditionsTree"] = fakeConditionTree(rules["conditions"])
+			delete(rules, "conditions")
+		}
+		// Jira adds its essential post functions to each transition
+		postFunctions, _ := rules["postFunctions"].([]interface{})
+		for _, postFunction := range fakeWorkflowPostFunctions(transition["type"]) {
+			postFunctions = append(postFunctions, fakeObject{"type": postFunction})
+		}
+		rules["postFunctions"] = postFunctions
+		transition["rules"] = rules
+	}
+	if initial != 1 {
+		return http.StatusBadRequest, fakeFieldError("transitions", "The workflow must contain exactly one initial transition.")
+	}
+
+	id := f.nextID()
+	workflow["id"] = fakeObject{"name": name, "entityId": fmt.Sprintf("%08x-0000-4000-8000-%012x", id, id)}
+	workflow["transitions"] = transitions
+	f.workflows[name] = workflow
+
+	return http.StatusCreated, workflow["id"]
+}
+
+func (f *fakeJira) searchWorkflows(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if errs := fakeRequireAdministrator(r); errs != nil {
+		return http.StatusForbidden, errs
+	}
+	names := r.URL.Query()["workflowName"]
+
+	values := []fakeObject{}
+	for _, name := range sortedKeys(f.workflows) {
+		if len(names) == 0 || containsString(names, name) {
+			values = append(values, f.workflows[name])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+// workflowSchemeUses reports whether the workflow scheme or its draft uses the workflow
+func workflowSchemeUses(scheme fakeObject, name string) bool {
+	if scheme["defaultWorkflow"] == name {
+		return true
+	}
+	for _, workflow := range reference(scheme["issueTypeMappings"]) {
+		if workflow == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeJira) deleteWorkflow(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	for _, name := range sortedKeys(f.workflows) {
+		if reference(f.workflows[name]["id"])["entityId"] != params[0] {
+			continue
+		}
+
+		if name == "jira" {
+			return http.StatusBadRequest, fakeError("The system workflow cannot be deleted.")
+		}
+		for _, c := range []fakeCollection{f.workflowSchemes, f.workflowDrafts} {
+			for _, id := range sortedKeys(c) {
+				if workflowSchemeUses(c[id], name) {
+					return http.StatusBadRequest, fakeError("Cannot delete workflow '%s', it is associated with a workflow scheme.", name)
+				}
+			}
+		}
+
+		delete(f.workflows, name)
+		return http.StatusNoContent, nil
+	}
+	return http.StatusNotFound, fakeError("The workflow %s does not exist.", params[0])
+}
+
+func (f *fakeJira) prepareWorkflowScheme(obj, body fakeObject) fakeObject {
+	if obj == nil {
+		if name, _ := body["name"].(string); name == "" {
+			return fakeFieldError("name", "The workflow scheme name must be specified.")
+		}
+		if workflow, _ := body["defaultWorkflow"].(string); workflow == "" {
+			body["defaultWorkflow"] = "jira"
+		}
+		if body["issueTypeMappings"] == nil {
+			body["issueTypeMappings"] = fakeObject{}
+		}
+	}
+
+	if workflow, ok := body["defaultWorkflow"].(string); ok && workflow != "" {
+		if _, ok := f.workflows[workflow]; !ok {
+			return fakeFieldError("defaultWorkflow", "The workflow %s does not exist.", workflow)
+		}
+	}
+	for issueTypeID, workflow := range reference(body["issueTypeMappings"]) {
+		if _, ok := f.issueTypes[issueTypeID]; !ok {
+			return fakeFieldError("issueTypeMappings", "The issue type %s does not exist.", issueTypeID)
+		}
+		if _, ok := f.workflows[fmt.Sprintf("%v", workflow)]; !ok {
+			return fakeFieldError("issueTypeMappings", "The workflow %v does not exist.", workflow)
+		}
+	}
+
+	delete(body, "draft")
+	delete(body, "updateDraftIfNeeded")
+	return nil
+}
+
+// workflowSchemeProjects returns the projects using the workflow scheme
+func (f *fakeJira) workflowSchemeProjects(id string) []fakeObject {
+	projects := []fakeObject{}
+	for _, key := range sortedKeys(f.projects) {
+		if fmt.Sprintf("%v", f.projects[key]["workflowScheme"]) == id {
+			projects = append(projects, f.projects[key])
+		}
+	}
+	return projects
+}
+
+func (f *fakeJira) updateWorkflowScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.workflowSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The workflow scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	updateDraftIfNeeded, _ := body["updateDraftIfNeeded"].(bool)
+	if errs := f.prepareWorkflowScheme(scheme, body); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	target := scheme
+	if len(f.workflowSchemeProjects(params[0])) > 0 {
+		if !updateDraftIfNeeded {
+			return http.StatusBadRequest, fakeError("The workflow scheme is active, set updateDraftIfNeeded to update its draft.")
+		}
+
+		target = f.workflowDrafts[params[0]]
+		if target == nil {
+			target = fakeObject{}
+			for k, v := range scheme {
+				target[k] = v
+			}
+			target["draft"] = true
+			f.workflowDrafts[params[0]] = target
+		}
+	}
+
+	for k, v := range body {
+		if k != "id" && k != "self" {
+			target[k] = v
+		}
+	}
+	return http.StatusOK, target
+}
+
+func (f *fakeJira) deleteWorkflowScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.workflowSchemes[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The workflow scheme %s does not exist.", params[0])
+	}
+	if len(f.workflowSchemeProjects(params[0])) > 0 {
+		return http.StatusBadRequest, fakeError("Cannot delete an active workflow scheme.")
+	}
+
+	delete(f.workflowSchemes, params[0])
+	delete(f.workflowDrafts, params[0])
+	return http.StatusNoContent, nil
+}
+
+// workflowOf returns the workflow of the issue type in the scheme. A nil
+// scheme is the default workflow scheme.
+func (f *fakeJira) workflowOf(scheme fakeObject, issueTypeID string) fakeObject {
+	name := "jira"
+	if scheme != nil {
+		name = fmt.Sprintf("%v", scheme["defaultWorkflow"])
+		if workflow, ok := reference(scheme["issueTypeMappings"])[issueTypeID]; ok {
+			name = fmt.Sprintf("%v", workflow)
+		}
+	}
+	return f.workflows[name]
+}
+
+// migrateIssues moves the issues of the project into the statuses of the
+// workflows of the scheme. mapping returns the new status of an issue type
+// and status not contained in the new workflow.
+func (f *fakeJira) migrateIssues(project, scheme fakeObject, mapping func(issueTypeID, statusID string) string) fakeObject {
+	migrated := map[string]string{}
+	for _, id := range sortedKeys(f.issues) {
+		fields := reference(f.issues[id]["fields"])
+		if reference(fields["project"])["id"] != project["id"] {
+			continue
+		}
+
+		issueTypeID := fmt.Sprintf("%v", reference(fields["issuetype"])["id"])
+		statusID := fmt.Sprintf("%v", reference(fields["status"])["id"])
+		workflow := f.workflowOf(scheme, issueTypeID)
+		if workflowHasStatus(workflow, statusID) {
+			continue
+		}
+
+		newStatusID := mapping(issueTypeID, statusID)
+		if newStatusID == "" {
+			return fakeError("The status %s of issue type %s needs to be mapped to a status of the workflow %v.", statusID, issueTypeID, reference(workflow["id"])["name"])
+		}
+		if !workflowHasStatus(workflow, newStatusID) {
+			return fakeError("The status %s is not part of the workflow %v.", newStatusID, reference(workflow["id"])["name"])
+		}
+		migrated[id] = newStatusID
+	}
+
+	for id, statusID := range migrated {
+		reference(f.issues[id]["fields"])["status"] = f.statuses[statusID]
+	}
+	return nil
+}
+
+func (f *fakeJira) getProjectWorkflowScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if errs := fakeRequireAdministrator(r); errs != nil {
+		return http.StatusForbidden, errs
+	}
+	projectID := r.URL.Query().Get("projectId")
+	project, ok := f.projects[projectID]
+	if !ok {
+		return http.StatusNotFound, fakeError("The project %s does not exist.", projectID)
+	}
+
+	// Team-managed projects don't use workflow schemes
+	if project["style"] == "next-gen" {
+		return http.StatusOK, fakePage([]fakeObject{})
+	}
+
+	scheme := fakeObject{
+		"name":              "Default Workflow Scheme",
+		"description":       "Default Workflow Scheme",
+		"defaultWorkflow":   "jira",
+		"issueTypeMappings": fakeObject{},
+	}
+	if id, ok := project["workflowScheme"]; ok {
+		scheme = f.workflowSchemes[fmt.Sprintf("%v", id)]
+	}
+
+	return http.StatusOK, fakeObject{"values": []fakeObject{{"projectIds": []string{projectID}, "workflowScheme": scheme}}}
+}
+
+func (f *fakeJira) switchProjectWorkflowScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	project, ok := f.projects[fmt.Sprintf("%v", body["projectId"])]
+	if !ok {
+		return http.StatusNotFound, fakeError("The project %v does not exist.", body["projectId"])
+	}
+	schemeID := fmt.Sprintf("%v", body["targetSchemeId"])
+	scheme, ok := f.workflowSchemes[schemeID]
+	if !ok {
+		return http.StatusNotFound, fakeError("The workflow scheme %s does not exist.", schemeID)
+	}
+
+	overrides, _ := body["mappingsByIssueTypeOverride"].([]interface{})
+	errs = f.migrateIssues(project, scheme, func(issueTypeID, statusID string) string {
+		for _, o := range overrides {
+			override := reference(o)
+			if override["issueTypeId"] != issueTypeID {
+				continue
+			}
+			mappings, _ := override["statusMappings"].([]interface{})
+			for _, m := range mappings {
+				if reference(m)["oldStatusId"] == statusID {
+					return fmt.Sprintf("%v", reference(m)["newStatusId"])
+				}
+			}
+		}
+		return ""
+	})
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	project["workflowScheme"], _ = strconv.Atoi(schemeID)
+	return f.startTask(w)
+}
+
+func (f *fakeJira) publishWorkflowScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	draft, ok := f.workflowDrafts[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The workflow scheme %s does not have a draft.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	statusMappings, _ := body["statusMappings"].([]interface{})
+	mapping := func(issueTypeID, statusID string) string {
+		for _, m := range statusMappings {
+			if reference(m)["issueTypeId"] == issueTypeID && reference(m)["statusId"] == statusID {
+				return fmt.Sprintf("%v", reference(m)["newStatusId"])
+			}
+		}
+		return ""
+	}
+	for _, project := range f.workflowSchemeProjects(params[0]) {
+		if errs := f.migrateIssues(project, draft, mapping); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+	}
+
+	scheme := f.workflowSchemes[params[0]]
+	for k, v := range draft {
+		if k != "draft" {
+			scheme[k] = v
+		}
+	}
+	delete(f.workflowDrafts, params[0])
+	return f.startTask(w)
+}
+
+func (f *fakeJira) getIssueTypes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	issueTypes := []fakeObject{}
+	for _, id := range sortedKeys(f.issueTypes) {
+		issueTypes = append(issueTypes, f.issueTypes[id])
+	}
+	return http.StatusOK, issueTypes
+}
+
+// issueTypeSchemeTypes returns the IDs of the issue types of the scheme. The
+// default scheme contains all issue types.
+func (f *fakeJira) issueTypeSchemeTypes(id string) []string {
+	if f.issueTypeSchemes[id]["isDefault"] == true {
+		return sortedKeys(f.issueTypes)
+	}
+	return f.issueTypeSchemeIssueTypes[id]
+}
+
+// projectIssueTypeScheme returns the issue type scheme used by the project
+func (f *fakeJira) projectIssueTypeScheme(project fakeObject) fakeObject {
+	if id, ok := project["issueTypeScheme"]; ok {
+		return f.issueTypeSchemes[fmt.Sprintf("%v", id)]
+	}
+	return f.issueTypeSchemes.find("isDefault", true)
+}
+
+// validateIssueTypeSchemeTypes checks that all issue types exist and that the
+// default is one of them
+func (f *fakeJira) validateIssueTypeSchemeTypes(issueTypeIDs []string, defaultID interface{}) fakeObject {
+	for _, id := range issueTypeIDs {
+		if _, ok := f.issueTypes[id]; !ok {
+			return fakeFieldError("issueTypeIds", "The issue type %s does not exist.", id)
+		}
+	}
+	if defaultID != nil && defaultID != "" && !containsString(issueTypeIDs, fmt.Sprintf("%v", defaultID)) {
+		return fakeFieldError("defaultIssueTypeId", "The default issue type %v is not part of the scheme.", defaultID)
+	}
+	return nil
+}
+
+func (f *fakeJira) createIssueTypeScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The issue type scheme name must be specified.")
+	}
+	if f.issueTypeSchemes.find("name", body["name"]) != nil {
+		return http.StatusConflict, fakeFieldError("name", "An issue type scheme with this name already exists.")
+	}
+
+	issueTypeIDs := fakeStrings(body["issueTypeIds"])
+	if len(issueTypeIDs) == 0 {
+		return http.StatusBadRequest, fakeFieldError("issueTypeIds", "The issue type scheme must contain at least one issue type.")
+	}
+	if errs := f.validateIssueTypeSchemeTypes(issueTypeIDs, body["defaultIssueTypeId"]); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	delete(body, "issueTypeIds")
+
+	scheme := f.insert(issueTypeSchemeAPIEndpoint, f.issueTypeSchemes, false, body)
+	f.issueTypeSchemeIssueTypes[scheme["id"].(string)] = issueTypeIDs
+	return http.StatusCreated, fakeObject{"issueTypeSchemeId": scheme["id"]}
+}
+
+func (f *fakeJira) getIssueTypeSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.issueTypeSchemes) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, f.issueTypeSchemes[id])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) getIssueTypeSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["issueTypeSchemeId"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.issueTypeSchemes) {
+		if len(ids) > 0 && !containsString(ids, id) {
+			continue
+		}
+		for _, issueTypeID := range f.issueTypeSchemeTypes(id) {
+			values = append(values, fakeObject{"issueTypeSchemeId": id, "issueTypeId": issueTypeID})
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateIssueTypeScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.issueTypeSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if errs := f.validateIssueTypeSchemeTypes(f.issueTypeSchemeTypes(params[0]), body["defaultIssueTypeId"]); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	for _, k := range []string{"name", "description", "defaultIssueTypeId"} {
+		if v, ok := body[k]; ok {
+			scheme[k] = v
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteIssueTypeScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.issueTypeSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type scheme %s does not exist.", params[0])
+	}
+	if scheme["isDefault"] == true {
+		return http.StatusBadRequest, fakeError("The default issue type scheme cannot be deleted.")
+	}
+
+	// Projects using the scheme fall back to the default scheme
+	for _, project := range f.projects {
+		if project["issueTypeScheme"] == params[0] {
+			delete(project, "issueTypeScheme")
+		}
+	}
+	delete(f.issueTypeSchemes, params[0])
+	delete(f.issueTypeSchemeIssueTypes, params[0])
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) addIssueTypeSchemeTypes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.issueTypeSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type scheme %s does not exist.", params[0])
+	}
+	if scheme["isDefault"] == true {
+		return http.StatusBadRequest, fakeError("The default issue type scheme always contains all issue types.")
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	added := fakeStrings(body["issueTypeIds"])
+	if errs := f.validateIssueTypeSchemeTypes(added, nil); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	for _, id := range added {
+		if containsString(f.issueTypeSchemeIssueTypes[params[0]], id) {
+			return http.StatusBadRequest, fakeError("The issue type %s is already part of the scheme.", id)
+		}
+	}
+
+	f.issueTypeSchemeIssueTypes[params[0]] = append(f.issueTypeSchemeIssueTypes[params[0]], added...)
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) removeIssueTypeSchemeType(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.issueTypeSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type scheme %s does not exist.", params[0])
+	}
+	issueTypeIDs := f.issueTypeSchemeIssueTypes[params[0]]
+	if !containsString(issueTypeIDs, params[1]) {
+		return http.StatusNotFound, fakeError("The issue type %s is not part of the scheme.", params[1])
+	}
+	if fmt.Sprintf("%v", scheme["defaultIssueTypeId"]) == params[1] {
+		return http.StatusBadRequest, fakeError("The default issue type cannot be removed from the scheme.")
+	}
+	if len(issueTypeIDs) == 1 {
+		return http.StatusBadRequest, fakeError("The last issue type cannot be removed from the scheme.")
+	}
+
+	remaining := []string{}
+	for _, id := range issueTypeIDs {
+		if id != params[1] {
+			remaining = append(remaining, id)
+		}
+	}
+	f.issueTypeSchemeIssueTypes[params[0]] = remaining
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) moveIssueTypeSchemeTypes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.issueTypeSchemes[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The issue type scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	moved := fakeStrings(body["issueTypeIds"])
+	rest := []string{}
+	for _, id := range moved {
+		if !containsString(f.issueTypeSchemeIssueTypes[params[0]], id) {
+			return http.StatusBadRequest, fakeError("The issue type %s is not part of the scheme.", id)
+		}
+	}
+	for _, id := range f.issueTypeSchemeIssueTypes[params[0]] {
+		if !containsString(moved, id) {
+			rest = append(rest, id)
+		}
+	}
+
+	switch body["position"] {
+	case "First":
+		f.issueTypeSchemeIssueTypes[params[0]] = append(moved, rest...)
+	case "Last":
+		f.issueTypeSchemeIssueTypes[params[0]] = append(rest, moved...)
+	default:
+		return http.StatusBadRequest, fakeFieldError("position", "Only First and Last are supported.")
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getProjectIssueTypeSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	values := []fakeObject{}
+	for _, projectID := range r.URL.Query()["projectId"] {
+		project, ok := f.projects[projectID]
+		if !ok {
+			continue
+		}
+		values = append(values, fakeObject{"issueTypeScheme": f.projectIssueTypeScheme(project), "projectIds": []string{projectID}})
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) assignProjectIssueTypeScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	project, ok := f.projects[fmt.Sprintf("%v", body["projectId"])]
+	if !ok {
+		return http.StatusNotFound, fakeError("The project %v does not exist.", body["projectId"])
+	}
+	schemeID := fmt.Sprintf("%v", body["issueTypeSchemeId"])
+	if _, ok := f.issueTypeSchemes[schemeID]; !ok {
+		return http.StatusNotFound, fakeError("The issue type scheme %s does not exist.", schemeID)
+	}
+
+	// Issues need to keep their issue type
+	issueTypeIDs := f.issueTypeSchemeTypes(schemeID)
+	for _, issue := range f.issues {
+		fields := reference(issue["fields"])
+		issueTypeID := fmt.Sprintf("%v", reference(fields["issuetype"])["id"])
+		if reference(fields["project"])["id"] == project["id"] && !containsString(issueTypeIDs, issueTypeID) {
+			return http.StatusBadRequest, fakeError("The project has issues of the issue type %s, which is not part of the scheme.", issueTypeID)
+		}
+	}
+
+	project["issueTypeScheme"] = schemeID
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) createScreen(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The screen name must be specified.")
+	}
+	if f.screens.find("name", body["name"]) != nil {
+		return http.StatusBadRequest, fakeFieldError("name", "A screen with this name already exists.")
+	}
+
+	screen := f.insert(screenAPIEndpoint, f.screens, true, body)
+	// New screens have a single tab
+	f.screenTabs[fmt.Sprintf("%v", screen["id"])] = []fakeObject{{"id": f.nextID(), "name": "Field Tab"}}
+	return http.StatusOK, screen
+}
+
+func (f *fakeJira) getScreens(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.screens) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, f.screens[id])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateScreen(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	screen, ok := f.screens[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The screen %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	for _, k := range []string{"name", "description"} {
+		if v, ok := body[k]; ok {
+			screen[k] = v
+		}
+	}
+	return http.StatusOK, screen
+}
+
+func (f *fakeJira) deleteScreen(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.screens[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The screen %s does not exist.", params[0])
+	}
+	for _, scheme := range f.screenSchemes {
+		for _, screenID := range reference(scheme["screens"]) {
+			if fmt.Sprintf("%v", screenID) == params[0] {
+				return http.StatusBadRequest, fakeError("The screen is used by the screen scheme %v.", scheme["name"])
+			}
+		}
+	}
+
+	for _, tab := range f.screenTabs[params[0]] {
+		delete(f.screenTabFields, fmt.Sprintf("%v", tab["id"]))
+	}
+	delete(f.screenTabs, params[0])
+	delete(f.screens, params[0])
+	return http.StatusNoContent, nil
+}
+
+// screenTab returns the index of the tab params[1] of the screen params[0]
+func (f *fakeJira) screenTab(params []string) (int, fakeObject) {
+	if _, ok := f.screens[params[0]]; !ok {
+		return -1, fakeError("The screen %s does not exist.", params[0])
+	}
+	for i, tab := range f.screenTabs[params[0]] {
+		if fmt.Sprintf("%v", tab["id"]) == params[1] {
+			return i, nil
+		}
+	}
+	return -1, fakeError("The screen tab %s does not exist.", params[1])
+}
+
+func (f *fakeJira) getScreenTabs(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.screens[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The screen %s does not exist.", params[0])
+	}
+	return http.StatusOK, f.screenTabs[params[0]]
+}
+
+func (f *fakeJira) createScreenTab(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.screens[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The screen %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The tab name must be specified.")
+	}
+	for _, tab := range f.screenTabs[params[0]] {
+		if tab["name"] == body["name"] {
+			return http.StatusBadRequest, fakeFieldError("name", "A tab with this name already exists on the screen.")
+		}
+	}
+
+	tab := fakeObject{"id": f.nextID(), "name": body["name"]}
+	f.screenTabs[params[0]] = append(f.screenTabs[params[0]], tab)
+	return http.StatusOK, tab
+}
+
+func (f *fakeJira) updateScreenTab(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	i, errs := f.screenTab(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	tab := f.screenTabs[params[0]][i]
+	tab["name"] = body["name"]
+	return http.StatusOK, tab
+}
+
+func (f *fakeJira) deleteScreenTab(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	i, errs := f.screenTab(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	tabs := f.screenTabs[params[0]]
+	if len(tabs) == 1 {
+		return http.StatusBadRequest, fakeError("The last tab of a screen cannot be deleted.")
+	}
+
+	f.screenTabs[params[0]] = append(tabs[:i:i], tabs[i+1:]...)
+	delete(f.screenTabFields, params[1])
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) moveScreenTab(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	i, errs := f.screenTab(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	tabs := f.screenTabs[params[0]]
+	position, _ := strconv.Atoi(params[2])
+	if position >= len(tabs) {
+		return http.StatusBadRequest, fakeError("The position %d is invalid.", position)
+	}
+
+	tab := tabs[i]
+	rest := append(tabs[:i:i], tabs[i+1:]...)
+	f.screenTabs[params[0]] = append(rest[:position:position], append([]fakeObject{tab}, rest[position:]...)...)
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getScreenTabFields(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.screenTab(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+	fields := f.screenTabFields[params[1]]
+	if fields == nil {
+		fields = []fakeObject{}
+	}
+	return http.StatusOK, fields
+}
+
+// screenTabField returns the index of the field params[2] on the tab params[1]
+func (f *fakeJira) screenTabField(params []string) (int, fakeObject) {
+	if _, errs := f.screenTab(params); errs != nil {
+		return -1, errs
+	}
+	for i, field := range f.screenTabFields[params[1]] {
+		if field["id"] == params[2] {
+			return i, nil
+		}
+	}
+	return -1, fakeError("The field %s is not on the tab.", params[2])
+}
+
+func (f *fakeJira) addScreenTabField(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, errs := f.screenTab(params); errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	fieldID := fmt.Sprintf("%v", body["fieldId"])
+	field, ok := f.fields[fieldID]
+	if !ok {
+		return http.StatusBadRequest, fakeFieldError("fieldId", "The field %s does not exist.", fieldID)
+	}
+	for _, tab := range f.screenTabs[params[0]] {
+		for _, existing := range f.screenTabFields[fmt.Sprintf("%v", tab["id"])] {
+			if existing["id"] == fieldID {
+				return http.StatusBadRequest, fakeFieldError("fieldId", "The field %s is already on the screen.", fieldID)
+			}
+		}
+	}
+
+	tabField := fakeObject{"id": fieldID, "name": field["name"]}
+	f.screenTabFields[params[1]] = append(f.screenTabFields[params[1]], tabField)
+	return http.StatusOK, tabField
+}
+
+func (f *fakeJira) removeScreenTabField(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	i, errs := f.screenTabField(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+	fields := f.screenTabFields[params[1]]
+	f.screenTabFields[params[1]] = append(fields[:i:i], fields[i+1:]...)
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) moveScreenTabField(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	i, errs := f.screenTabField(params)
+	if errs != nil {
+		return http.StatusNotFound, errs
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	fields := f.screenTabFields[params[1]]
+	field := fields[i]
+	rest := append(fields[:i:i], fields[i+1:]...)
+	switch body["position"] {
+	case "First":
+		f.screenTabFields[params[1]] = append([]fakeObject{field}, rest...)
+	case "Last":
+		f.screenTabFields[params[1]] = append(rest, field)
+	default:
+		return http.StatusBadRequest, fakeFieldError("position", "Only First and Last are supported.")
+	}
+	return http.StatusNoContent, nil
+}
+
+// prepareScreenSchemeScreens validates the screens of a screen scheme. A nil
+// screen removes the operation from the scheme.
+func (f *fakeJira) prepareScreenSchemeScreens(screens fakeObject) fakeObject {
+	for operation, id := range screens {
+		if id == nil && operation != "default" {
+			continue
+		}
+		if _, ok := f.screens[fmt.Sprintf("%v", id)]; !ok {
+			return fakeFieldError("screens", "The screen %v does not exist.", id)
+		}
+		screens[operation], _ = strconv.Atoi(fmt.Sprintf("%v", id))
+	}
+	return nil
+}
+
+func (f *fakeJira) createScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The screen scheme name must be specified.")
+	}
+	screens := reference(body["screens"])
+	if _, ok := screens["default"]; !ok {
+		return http.StatusBadRequest, fakeFieldError("screens", "The default screen must be specified.")
+	}
+	if errs := f.prepareScreenSchemeScreens(screens); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	body["screens"] = screens
+
+	scheme := f.insert(screenSchemeAPIEndpoint, f.screenSchemes, true, body)
+	return http.StatusCreated, fakeObject{"id": scheme["id"]}
+}
+
+func (f *fakeJira) getScreenSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.screenSchemes) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, f.screenSchemes[id])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.screenSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The screen scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if screens, ok := body["screens"]; ok {
+		updated := fakeObject{}
+		for k, v := range reference(scheme["screens"]) {
+			updated[k] = v
+		}
+		for k, v := range reference(screens) {
+			updated[k] = v
+		}
+		if errs := f.prepareScreenSchemeScreens(updated); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+		for k, v := range updated {
+			if v == nil {
+				delete(updated, k)
+			}
+		}
+		scheme["screens"] = updated
+	}
+	for _, k := range []string{"name", "description"} {
+		if v, ok := body[k]; ok {
+			scheme[k] = v
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.screenSchemes[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The screen scheme %s does not exist.", params[0])
+	}
+	for id, mappings := range f.issueTypeScreenSchemeMappings {
+		for _, screenSchemeID := range mappings {
+			if screenSchemeID == params[0] {
+				return http.StatusBadRequest, fakeError("The screen scheme is used by the issue type screen scheme %v.", f.issueTypeScreenSchemes[id]["name"])
+			}
+		}
+	}
+
+	delete(f.screenSchemes, params[0])
+	return http.StatusNoContent, nil
+}
+
+// prepareIssueTypeScreenSchemeMappings validates the mappings and adds them to
+// the mappings of the scheme
+func (f *fakeJira) prepareIssueTypeScreenSchemeMappings(mappings map[string]string, body []interface{}) fakeObject {
+	for _, m := range body {
+		issueTypeID := fmt.Sprintf("%v", reference(m)["issueTypeId"])
+		screenSchemeID := fmt.Sprintf("%v", reference(m)["screenSchemeId"])
+		if _, ok := f.issueTypes[issueTypeID]; !ok && issueTypeID != "default" {
+			return fakeFieldError("issueTypeMappings", "The issue type %s does not exist.", issueTypeID)
+		}
+		if _, ok := f.screenSchemes[screenSchemeID]; !ok {
+			return fakeFieldError("issueTypeMappings", "The screen scheme %s does not exist.", screenSchemeID)
+		}
+		if _, ok := mappings[issueTypeID]; ok {
+			return fakeFieldError("issueTypeMappings", "The issue type %s is already mapped.", issueTypeID)
+		}
+		mappings[issueTypeID] = screenSchemeID
+	}
+	return nil
+}
+
+func (f *fakeJira) createIssueTypeScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The issue type screen scheme name must be specified.")
+	}
+
+	mappings := map[string]string{}
+	issueTypeMappings, _ := body["issueTypeMappings"].([]interface{})
+	if errs := f.prepareIssueTypeScreenSchemeMappings(mappings, issueTypeMappings); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if _, ok := mappings["default"]; !ok {
+		return http.StatusBadRequest, fakeFieldError("issueTypeMappings", "The default screen scheme must be specified.")
+	}
+	delete(body, "issueTypeMappings")
+
+	scheme := f.insert(issueTypeScreenSchemeAPIEndpoint, f.issueTypeScreenSchemes, false, body)
+	f.issueTypeScreenSchemeMappings[scheme["id"].(string)] = mappings
+	return http.StatusCreated, fakeObject{"id": scheme["id"]}
+}
+
+func (f *fakeJira) getIssueTypeScreenSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.issueTypeScreenSchemes) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, f.issueTypeScreenSchemes[id])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) getIssueTypeScreenSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["issueTypeScreenSchemeId"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.issueTypeScreenSchemes) {
+		if len(ids) > 0 && !containsString(ids, id) {
+			continue
+		}
+		mappings := f.issueTypeScreenSchemeMappings[id]
+		issueTypeIDs := make([]string, 0, len(mappings))
+		for issueTypeID := range mappings {
+			issueTypeIDs = append(issueTypeIDs, issueTypeID)
+		}
+		sort.Strings(issueTypeIDs)
+		for _, issueTypeID := range issueTypeIDs {
+			values = append(values, fakeObject{
+				"issueTypeScreenSchemeId": id,
+				"issueTypeId":             issueTypeID,
+				"screenSchemeId":          mappings[issueTypeID],
+			})
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateIssueTypeScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.issueTypeScreenSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type screen scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	for _, k := range []string{"name", "description"} {
+		if v, ok := body[k]; ok {
+			scheme[k] = v
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) addIssueTypeScreenSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	mappings, ok := f.issueTypeScreenSchemeMappings[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type screen scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	issueTypeMappings, _ := body["issueTypeMappings"].([]interface{})
+	for _, m := range issueTypeMappings {
+		if reference(m)["issueTypeId"] == "default" {
+			return http.StatusBadRequest, fakeFieldError("issueTypeMappings", "The default mapping is updated using mapping/default.")
+		}
+	}
+
+	updated := map[string]string{}
+	for k, v := range mappings {
+		updated[k] = v
+	}
+	if errs := f.prepareIssueTypeScreenSchemeMappings(updated, issueTypeMappings); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	f.issueTypeScreenSchemeMappings[params[0]] = updated
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) setIssueTypeScreenSchemeDefault(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	mappings, ok := f.issueTypeScreenSchemeMappings[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type screen scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	screenSchemeID := fmt.Sprintf("%v", body["screenSchemeId"])
+	if _, ok := f.screenSchemes[screenSchemeID]; !ok {
+		return http.StatusBadRequest, fakeFieldError("screenSchemeId", "The screen scheme %s does not exist.", screenSchemeID)
+	}
+	mappings["default"] = screenSchemeID
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) removeIssueTypeScreenSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	mappings, ok := f.issueTypeScreenSchemeMappings[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type screen scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	removed := fakeStrings(body["issueTypeIds"])
+	for _, issueTypeID := range removed {
+		if _, ok := mappings[issueTypeID]; !ok || issueTypeID == "default" {
+			return http.StatusBadRequest, fakeFieldError("issueTypeIds", "The issue type %s is not mapped.", issueTypeID)
+		}
+	}
+	for _, issueTypeID := range removed {
+		delete(mappings, issueTypeID)
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteIssueTypeScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.issueTypeScreenSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The issue type screen scheme %s does not exist.", params[0])
+	}
+	if scheme["isDefault"] == true {
+		return http.StatusBadRequest, fakeError("The default issue type screen scheme cannot be deleted.")
+	}
+	for _, project := range f.projects {
+		if project["issueTypeScreenScheme"] == params[0] {
+			return http.StatusBadRequest, fakeError("The issue type screen scheme is used by the project %v.", project["key"])
+		}
+	}
+
+	delete(f.issueTypeScreenSchemes, params[0])
+	delete(f.issueTypeScreenSchemeMappings, params[0])
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getProjectIssueTypeScreenSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	values := []fakeObject{}
+	for _, projectID := range r.URL.Query()["projectId"] {
+		project, ok := f.projects[projectID]
+		if !ok {
+			continue
+		}
+		scheme := f.issueTypeScreenSchemes.find("isDefault", true)
+		if id, ok := project["issueTypeScreenScheme"]; ok {
+			scheme = f.issueTypeScreenSchemes[fmt.Sprintf("%v", id)]
+		}
+		values = append(values, fakeObject{"issueTypeScreenScheme": scheme, "projectIds": []string{projectID}})
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) assignProjectIssueTypeScreenScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	project, ok := f.projects[fmt.Sprintf("%v", body["projectId"])]
+	if !ok {
+		return http.StatusNotFound, fakeError("The project %v does not exist.", body["projectId"])
+	}
+	schemeID := fmt.Sprintf("%v", body["issueTypeScreenSchemeId"])
+	if _, ok := f.issueTypeScreenSchemes[schemeID]; !ok {
+		return http.StatusNotFound, fakeError("The issue type screen scheme %s does not exist.", schemeID)
+	}
+
+	project["issueTypeScreenScheme"] = schemeID
+	return http.StatusNoContent, nil
+}
+
+// projectFieldConfiguration returns the ID of the field configuration used by
+// the issues of the issue type in the project
+func (f *fakeJira) projectFieldConfiguration(project fakeObject, issueTypeID string) string {
+	mappings, ok := f.fieldConfigurationSchemeMappings[fmt.Sprintf("%v", project["fieldConfigurationScheme"])]
+	if !ok {
+		return fmt.Sprintf("%v", f.fieldConfigurations.find("isDefault", true)["id"])
+	}
+	if id, ok := mappings[issueTypeID]; ok {
+		return id
+	}
+	return mappings["default"]
+}
+
+func (f *fakeJira) createFieldConfiguration(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The field configuration name must be specified.")
+	}
+	if f.fieldConfigurations.find("name", body["name"]) != nil {
+		return http.StatusBadRequest, fakeFieldError("name", "A field configuration with this name already exists.")
+	}
+
+	configuration := f.insert(fieldConfigurationAPIEndpoint, f.fieldConfigurations, true, fakeObject{
+		"name":        body["name"],
+		"description": body["description"],
+	})
+	return http.StatusOK, configuration
+}
+
+func (f *fakeJira) getFieldConfigurations(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.fieldConfigurations) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, f.fieldConfigurations[id])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateFieldConfiguration(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	configuration, ok := f.fieldConfigurations[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The field configuration %s does not exist.", params[0])
+	}
+	if configuration["isDefault"] == true {
+		return http.StatusBadRequest, fakeError("The default field configuration cannot be updated.")
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The field configuration name must be specified.")
+	}
+	configuration["name"] = body["name"]
+	configuration["description"] = body["description"]
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteFieldConfiguration(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	configuration, ok := f.fieldConfigurations[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The field configuration %s does not exist.", params[0])
+	}
+	if configuration["isDefault"] == true {
+		return http.StatusBadRequest, fakeError("The default field configuration cannot be deleted.")
+	}
+	for id, mappings := range f.fieldConfigurationSchemeMappings {
+		for _, configurationID := range mappings {
+			if configurationID == params[0] {
+				return http.StatusBadRequest, fakeError("The field configuration is used by the field configuration scheme %v.", f.fieldConfigurationSchemes[id]["name"])
+			}
+		}
+	}
+
+	delete(f.fieldConfigurations, params[0])
+	delete(f.fieldConfigurationItems, params[0])
+	return http.StatusNoContent, nil
+}
+
+// fieldConfigurationItem returns the configuration of the field, falling back
+// to the defaults
+func (f *fakeJira) fieldConfigurationItem(configurationID, fieldID string) fakeObject {
+	item := fakeObject{"id": fieldID, "isHidden": false, "isRequired": false, "description": ""}
+	if reference(f.fields[fieldID]["schema"])["type"] == "string" {
+		item["renderer"] = "wiki-renderer"
+	}
+	for k, v := range f.fieldConfigurationItems[configurationID][fieldID] {
+		item[k] = v
+	}
+	return item
+}
+
+func (f *fakeJira) getFieldConfigurationItems(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.fieldConfigurations[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The field configuration %s does not exist.", params[0])
+	}
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.fields) {
+		values = append(values, f.fieldConfigurationItem(params[0], id))
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateFieldConfigurationItems(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.fieldConfigurations[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The field configuration %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	items, _ := body["fieldConfigurationItems"].([]interface{})
+	updated := map[string]fakeObject{}
+	for _, v := range items {
+		item := reference(v)
+		fieldID := fmt.Sprintf("%v", item["id"])
+		field, ok := f.fields[fieldID]
+		if !ok {
+			return http.StatusBadRequest, fakeFieldError("fieldConfigurationItems", "The field %s does not exist.", fieldID)
+		}
+		if item["isHidden"] == true && item["isRequired"] == true {
+			return http.StatusBadRequest, fakeFieldError("fieldConfigurationItems", "The field %s cannot be hidden and required.", fieldID)
+		}
+		if renderer, ok := item["renderer"]; ok {
+			if reference(field["schema"])["type"] != "string" {
+				return http.StatusBadRequest, fakeFieldError("fieldConfigurationItems", "The renderer of the field %s cannot be changed.", fieldID)
+			}
+			if renderer != "wiki-renderer" && renderer != "text-renderer" {
+				return http.StatusBadRequest, fakeFieldError("fieldConfigurationItems", "The renderer %v is not supported.", renderer)
+			}
+		}
+		updated[fieldID] = item
+	}
+
+	if f.fieldConfigurationItems[params[0]] == nil {
+		f.fieldConfigurationItems[params[0]] = map[string]fakeObject{}
+	}
+	for fieldID, item := range updated {
+		// Omitted attributes are left unchanged
+		current := f.fieldConfigurationItem(params[0], fieldID)
+		for k, v := range item {
+			current[k] = v
+		}
+		f.fieldConfigurationItems[params[0]][fieldID] = current
+	}
+	return http.StatusNoContent, nil
+}
+
+// prepareFieldConfigurationSchemeMappings validates the mappings and adds or
+// replaces them in the mappings of the scheme
+func (f *fakeJira) prepareFieldConfigurationSchemeMappings(mappings map[string]string, body []interface{}) fakeObject {
+	for _, m := range body {
+		issueTypeID := fmt.Sprintf("%v", reference(m)["issueTypeId"])
+		configurationID := fmt.Sprintf("%v", reference(m)["fieldConfigurationId"])
+		if _, ok := f.issueTypes[issueTypeID]; !ok && issueTypeID != "default" {
+			return fakeFieldError("mappings", "The issue type %s does not exist.", issueTypeID)
+		}
+		if _, ok := f.fieldConfigurations[configurationID]; !ok {
+			return fakeFieldError("mappings", "The field configuration %s does not exist.", configurationID)
+		}
+		mappings[issueTypeID] = configurationID
+	}
+	return nil
+}
+
+func (f *fakeJira) createFieldConfigurationScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The field configuration scheme name must be specified.")
+	}
+
+	scheme := f.insert(fieldConfigurationSchemeAPIEndpoint, f.fieldConfigurationSchemes, false, fakeObject{
+		"name":        body["name"],
+		"description": body["description"],
+	})
+	f.fieldConfigurationSchemeMappings[scheme["id"].(string)] = map[string]string{
+		"default": fmt.Sprintf("%v", f.fieldConfigurations.find("isDefault", true)["id"]),
+	}
+	return http.StatusCreated, scheme
+}
+
+func (f *fakeJira) getFieldConfigurationSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.fieldConfigurationSchemes) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, f.fieldConfigurationSchemes[id])
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) getFieldConfigurationSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["fieldConfigurationSchemeId"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.fieldConfigurationSchemes) {
+		if len(ids) > 0 && !containsString(ids, id) {
+			continue
+		}
+		mappings := f.fieldConfigurationSchemeMappings[id]
+		issueTypeIDs := make([]string, 0, len(mappings))
+		for issueTypeID := range mappings {
+			issueTypeIDs = append(issueTypeIDs, issueTypeID)
+		}
+		sort.Strings(issueTypeIDs)
+		for _, issueTypeID := range issueTypeIDs {
+			values = append(values, fakeObject{
+				"fieldConfigurationSchemeId": id,
+				"issueTypeId":                issueTypeID,
+				"fieldConfigurationId":       mappings[issueTypeID],
+			})
+		}
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) updateFieldConfigurationScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.fieldConfigurationSchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The field configuration scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if name, _ := body["name"].(string); name == "" {
+		return http.StatusBadRequest, fakeFieldError("name", "The field configuration scheme name must be specified.")
+	}
+	scheme["name"] = body["name"]
+	scheme["description"] = body["description"]
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) updateFieldConfigurationSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	mappings, ok := f.fieldConfigurationSchemeMappings[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The field configuration scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	updated := map[string]string{}
+	for k, v := range mappings {
+		updated[k] = v
+	}
+	items, _ := body["mappings"].([]interface{})
+	if errs := f.prepareFieldConfigurationSchemeMappings(updated, items); errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	f.fieldConfigurationSchemeMappings[params[0]] = updated
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) removeFieldConfigurationSchemeMappings(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	mappings, ok := f.fieldConfigurationSchemeMappings[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The field configuration scheme %s does not exist.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	removed := fakeStrings(body["issueTypeIds"])
+	for _, issueTypeID := range removed {
+		if _, ok := mappings[issueTypeID]; !ok || issueTypeID == "default" {
+			return http.StatusBadRequest, fakeFieldError("issueTypeIds", "The issue type %s is not mapped.", issueTypeID)
+		}
+	}
+	for _, issueTypeID := range removed {
+		delete(mappings, issueTypeID)
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteFieldConfigurationScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.fieldConfigurationSchemes[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The field configuration scheme %s does not exist.", params[0])
+	}
+	for _, project := range f.projects {
+		if project["fieldConfigurationScheme"] == params[0] {
+			return http.StatusBadRequest, fakeError("The field configuration scheme is used by the project %v.", project["key"])
+		}
+	}
+
+	delete(f.fieldConfigurationSchemes, params[0])
+	delete(f.fieldConfigurationSchemeMappings, params[0])
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getProjectFieldConfigurationSchemes(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	values := []fakeObject{}
+	for _, projectID := range r.URL.Query()["projectId"] {
+		project, ok := f.projects[projectID]
+		if !ok {
+			continue
+		}
+		// Projects using the default field configuration have no scheme
+		value := fakeObject{"projectIds": []string{projectID}}
+		if id, ok := project["fieldConfigurationScheme"]; ok {
+			value["fieldConfigurationScheme"] = f.fieldConfigurationSchemes[fmt.Sprintf("%v", id)]
+		}
+		values = append(values, value)
+	}
+	return http.StatusOK, fakePage(values)
+}
+
+func (f *fakeJira) assignProjectFieldConfigurationScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	project, ok := f.projects[fmt.Sprintf("%v", body["projectId"])]
+	if !ok {
+		return http.StatusNotFound, fakeError("The project %v does not exist.", body["projectId"])
+	}
+	if body["fieldConfigurationSchemeId"] == nil {
+		delete(project, "fieldConfigurationScheme")
+		return http.StatusNoContent, nil
+	}
+	schemeID := fmt.Sprintf("%v", body["fieldConfigurationSchemeId"])
+	if _, ok := f.fieldConfigurationSchemes[schemeID]; !ok {
+		return http.StatusNotFound, fakeError("The field configuration scheme %s does not exist.", schemeID)
+	}
+
+	project["fieldConfigurationScheme"] = schemeID
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) preparePriority(obj, body fakeObject) fakeObject {
+	if name, _ := body["name"].(string); name == "" {
+		return fakeFieldError("name", "The priority name must be specified.")
+	}
+	if existing := f.priorities.find("name", body["name"]); existing != nil && (obj == nil || existing["id"] != obj["id"]) {
+		return fakeFieldError("name", "A priority with this name already exists.")
+	}
+	if color, _ := body["statusColor"].(string); !strings.HasPrefix(color, "#") {
+		return fakeFieldError("statusColor", "The status color must be specified as hex value.")
+	}
+	if iconURL, _ := body["iconUrl"].(string); iconURL == "" {
+		body["iconUrl"] = fmt.Sprintf("%s/images/icons/priorities/medium.svg", f.URL)
+	}
+	return nil
+}
+
+func (f *fakeJira) getPriorities(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.priorities) {
+		values = append(values, f.priorities[id])
+	}
+	return http.StatusOK, values
+}
+
+func (f *fakeJira) deletePriority(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.priorities[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The priority %s does not exist.", params[0])
+	}
+	for _, scheme := range f.prioritySchemes {
+		if scheme["defaultOptionId"] == params[0] && scheme["defaultScheme"] != true {
+			return http.StatusBadRequest, fakeError("The priority is the default of the priority scheme %v.", scheme["name"])
+		}
+	}
+
+	defaultScheme := f.prioritySchemes.find("defaultScheme", true)
+	for _, scheme := range f.prioritySchemes {
+		scheme["optionIds"] = without(fakeStrings(scheme["optionIds"]), params[0])
+	}
+	for _, issue := range f.issues {
+		fields := reference(issue["fields"])
+		if reference(fields["priority"])["id"] == params[0] {
+			fields["priority"] = f.priorities[fmt.Sprintf("%v", defaultScheme["defaultOptionId"])]
+		}
+	}
+	delete(f.priorities, params[0])
+	return f.startTask(w)
+}
+
+// preparePriorityScheme validates the priorities of a priority scheme
+func (f *fakeJira) preparePriorityScheme(obj, body fakeObject) fakeObject {
+	if name, _ := body["name"].(string); name == "" {
+		return fakeFieldError("name", "The priority scheme name must be specified.")
+	}
+	optionIDs := fakeStrings(body["optionIds"])
+	if len(optionIDs) == 0 {
+		return fakeFieldError("optionIds", "The priority scheme must contain at least one priority.")
+	}
+	for _, id := range optionIDs {
+		if _, ok := f.priorities[id]; !ok {
+			return fakeFieldError("optionIds", "The priority %s does not exist.", id)
+		}
+	}
+	body["optionIds"] = optionIDs
+	if defaultID, ok := body["defaultOptionId"]; ok && !containsString(optionIDs, fmt.Sprintf("%v", defaultID)) {
+		return fakeFieldError("defaultOptionId", "The default priority must be one of the priorities of the scheme.")
+	}
+	return nil
+}
+
+func (f *fakeJira) deletePriorityScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	scheme, ok := f.prioritySchemes[params[0]]
+	if !ok {
+		return http.StatusNotFound, fakeError("The priority scheme %s does not exist.", params[0])
+	}
+	if scheme["defaultScheme"] == true {
+		return http.StatusBadRequest, fakeError("The default priority scheme cannot be deleted.")
+	}
+
+	// Projects using the scheme fall back to the default scheme
+	for _, project := range f.projects {
+		if fmt.Sprintf("%v", project["priorityScheme"]) == params[0] {
+			delete(project, "priorityScheme")
+		}
+	}
+	delete(f.prioritySchemes, params[0])
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) getProjectPriorityScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	if id, ok := project["priorityScheme"]; ok {
+		return http.StatusOK, f.prioritySchemes[fmt.Sprintf("%v", id)]
+	}
+	return http.StatusOK, f.prioritySchemes.find("defaultScheme", true)
+}
+
+func (f *fakeJira) assignProjectPriorityScheme(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	scheme, ok := f.prioritySchemes[fmt.Sprintf("%v", body["id"])]
+	if !ok {
+		return http.StatusNotFound, fakeError("The priority scheme %v does not exist.", body["id"])
+	}
+
+	project["priorityScheme"] = scheme["id"]
+	return http.StatusOK, scheme
+}
+
+func (f *fakeJira) prepareResolution(obj, body fakeObject) fakeObject {
+	if name, _ := body["name"].(string); name == "" {
+		return fakeFieldError("name", "The resolution name must be specified.")
+	}
+	if existing := f.resolutions.find("name", body["name"]); existing != nil && (obj == nil || existing["id"] != obj["id"]) {
+		return fakeFieldError("name", "A resolution with this name already exists.")
+	}
+	return nil
+}
+
+func (f *fakeJira) getResolutions(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.resolutions) {
+		values = append(values, f.resolutions[id])
+	}
+	return http.StatusOK, values
+}
+
+func (f *fakeJira) deleteResolution(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	if _, ok := f.resolutions[params[0]]; !ok {
+		return http.StatusNotFound, fakeError("The resolution %s does not exist.", params[0])
+	}
+	replacement, ok := f.resolutions[r.URL.Query().Get("replaceWith")]
+	if !ok || replacement["id"] == params[0] {
+		return http.StatusBadRequest, fakeFieldError("replaceWith", "The resolution %s can't be replaced with %q.", params[0], r.URL.Query().Get("replaceWith"))
+	}
+
+	for _, issue := range f.issues {
+		fields := reference(issue["fields"])
+		if reference(fields["resolution"])["id"] == params[0] {
+			fields["resolution"] = replacement
+		}
+	}
+	delete(f.resolutions, params[0])
+	return f.startTask(w)
+}
+
+// fakeStatusCategory returns the status category of a status as returned by
+// the issue endpoints, given its key in the statuses endpoints
+func fakeStatusCategory(category string) fakeObject {
+	switch category {
+	case "TODO":
+		return fakeObject{"id": 2, "key": "new", "name": "To Do", "colorName": "blue-gray"}
+	case "IN_PROGRESS":
+		return fakeObject{"id": 4, "key": "indeterminate", "name": "In Progress", "colorName": "yellow"}
+	case "DONE":
+		return fakeObject{"id": 3, "key": "done", "name": "Done", "colorName": "green"}
+	}
+	return nil
+}
+
+// fakeStatusDetails returns the status as returned by the statuses endpoints
+func fakeStatusDetails(status fakeObject) fakeObject {
+	category := ""
+	for _, c := range []string{"TODO", "IN_PROGRESS", "DONE"} {
+		if fakeStatusCategory(c)["key"] == reference(status["statusCategory"])["key"] {
+			category = c
+		}
+	}
+	return fakeObject{
+		"id":             status["id"],
+		"name":           status["name"],
+		"description":    status["description"],
+		"statusCategory": category,
+		"scope":          fakeObject{"type": "GLOBAL"},
+	}
+}
+
+// prepareStatus validates a status of the statuses endpoints and converts it
+// into the representation returned by the issue endpoints
+func (f *fakeJira) prepareStatus(obj, body fakeObject) fakeObject {
+	if name, _ := body["name"].(string); name == "" {
+		return fakeFieldError("name", "The status name must be specified.")
+	}
+	if existing := f.statuses.find("name", body["name"]); existing != nil && (obj == nil || existing["id"] != obj["id"]) {
+		return fakeFieldError("name", "A status with the name %v already exists.", body["name"])
+	}
+	category := fakeStatusCategory(fmt.Sprintf("%v", body["statusCategory"]))
+	if category == nil {
+		return fakeFieldError("statusCategory", "The status category %v is invalid.", body["statusCategory"])
+	}
+	body["statusCategory"] = category
+	return nil
+}
+
+func (f *fakeJira) getStatusList(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.statuses) {
+		values = append(values, f.statuses[id])
+	}
+	return http.StatusOK, values
+}
+
+// getProjectStatuses returns the statuses of the workflow of each issue type
+// of the project
+func (f *fakeJira) getProjectStatuses(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	project := f.projects.lookup("key", params[0])
+	if project == nil {
+		return http.StatusNotFound, fakeError("No project could be found with key '%s'.", params[0])
+	}
+
+	var scheme fakeObject
+	if id, ok := project["workflowScheme"]; ok {
+		scheme = f.workflowSchemes[fmt.Sprintf("%v", id)]
+	}
+
+	values := []fakeObject{}
+	for _, issueTypeID := range f.issueTypeSchemeTypes(fmt.Sprintf("%v", f.projectIssueTypeScheme(project)["id"])) {
+		issueType := f.issueTypes[issueTypeID]
+		statuses := []fakeObject{}
+		workflowStatuses, _ := f.workflowOf(scheme, issueTypeID)["statuses"].([]interface{})
+		for _, status := range workflowStatuses {
+			statuses = append(statuses, f.statuses[fmt.Sprintf("%v", reference(status)["id"])])
+		}
+		values = append(values, fakeObject{
+			"id":       issueType["id"],
+			"name":     issueType["name"],
+			"subtask":  issueType["subtask"],
+			"statuses": statuses,
+		})
+	}
+	return http.StatusOK, values
+}
+
+func (f *fakeJira) createStatuses(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+	if reference(body["scope"])["type"] != "GLOBAL" {
+		return http.StatusBadRequest, fakeFieldError("scope", "Only statuses with GLOBAL scope are supported.")
+	}
+
+	statuses, _ := body["statuses"].([]interface{})
+	for _, v := range statuses {
+		if errs := f.prepareStatus(nil, reference(v)); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+	}
+	created := []fakeObject{}
+	for _, v := range statuses {
+		status := reference(v)
+		created = append(created, fakeStatusDetails(f.insert("/rest/api/2/status", f.statuses, false, fakeObject{
+			"name":           status["name"],
+			"description":    status["description"],
+			"statusCategory": status["statusCategory"],
+		})))
+	}
+	return http.StatusOK, created
+}
+
+func (f *fakeJira) getStatuses(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+
+	values := []fakeObject{}
+	for _, id := range sortedKeys(f.statuses) {
+		if len(ids) == 0 || containsString(ids, id) {
+			values = append(values, fakeStatusDetails(f.statuses[id]))
+		}
+	}
+	return http.StatusOK, values
+}
+
+func (f *fakeJira) updateStatuses(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	body, errs := decodeFakeBody(r)
+	if errs != nil {
+		return http.StatusBadRequest, errs
+	}
+
+	statuses, _ := body["statuses"].([]interface{})
+	for _, v := range statuses {
+		update := reference(v)
+		status, ok := f.statuses[fmt.Sprintf("%v", update["id"])]
+		if !ok {
+			return http.StatusNotFound, fakeError("The status %v does not exist.", update["id"])
+		}
+		if errs := f.prepareStatus(status, update); errs != nil {
+			return http.StatusBadRequest, errs
+		}
+	}
+	// Issues reference the status objects, so updating them in place updates
+	// the issues as well
+	for _, v := range statuses {
+		update := reference(v)
+		status := f.statuses[fmt.Sprintf("%v", update["id"])]
+		for _, k := range []string{"name", "description", "statusCategory"} {
+			status[k] = update[k]
+		}
+	}
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeJira) deleteStatuses(w http.ResponseWriter, r *http.Request, params []string) (int, interface{}) {
+	ids := r.URL.Query()["id"]
+	for _, id := range ids {
+		if _, ok := f.statuses[id]; !ok {
+			return http.StatusNotFound, fakeError("The status %s does not exist.", id)
+		}
+		for _, workflow := range f.workflows {
+			if workflowHasStatus(workflow, id) {
+				return http.StatusBadRequest, fakeError("The status %s is used by the workflow %v.", id, reference(workflow["id"])["name"])
+			}
+		}
+	}
+
+	for _, id := range ids {
+		delete(f.statuses, id)
+	}
+	return http.StatusNoContent, nil
+}
@@ -1,7 +1,11 @@
 package jira
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 )
 
@@ -37,37 +41,98 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("JIRA_TOKEN", nil),
 				Description: "Personal access token of a user. Can be specified with the JIRA_TOKEN environment variable.",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a request is retried when JIRA responds with 429 (Too Many Requests) or is temporarily unavailable. Defaults to 4.",
+			},
+			"retry_base_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1s",
+				ValidateFunc: validateDuration,
+				Description:  "Delay before the first retry, doubled with every subsequent attempt. Defaults to 1s.",
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "30s",
+				ValidateFunc: validateDuration,
+				Description:  "Upper bound for the delay between retries. Delays requested by JIRA using the Retry-After or X-RateLimit-Reset headers are always honored. Defaults to 30s.",
+			},
+			"api_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntInSlice([]int{2, 3}),
+				Description:  "Version of the JIRA REST API used for rich text. With version 3, available on Jira Cloud, issue descriptions and comments are exchanged in Atlassian Document Format. Defaults to 2.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"jira_comment":            resourceComment(),
-			"jira_component":          resourceComponent(),
-			"jira_filter":             resourceFilter(),
-			"jira_group":              resourceGroup(),
-			"jira_group_membership":   resourceGroupMembership(),
-			"jira_issue":              resourceIssue(),
-			"jira_issue_link":         resourceIssueLink(),
-			"jira_issue_type":         resourceIssueType(),
-			"jira_issue_link_type":    resourceIssueLinkType(),
-			"jira_project":            resourceProject(),
-			"jira_project_category":   resourceProjectCategory(),
-			"jira_project_membership": resourceProjectMembership(),
-			"jira_webhook":            resourceWebhook(),
-			"jira_role":               resourceRole(),
-			"jira_user":               resourceUser(),
+			"jira_comment":                    resourceComment(),
+			"jira_component":                  resourceComponent(),
+			"jira_custom_field":               resourceCustomField(),
+			"jira_custom_field_context":       resourceCustomFieldContext(),
+			"jira_custom_field_option":        resourceCustomFieldOption(),
+			"jira_field_configuration":        resourceFieldConfiguration(),
+			"jira_field_configuration_scheme": resourceFieldConfigurationScheme(),
+			"jira_filter":                     resourceFilter(),
+			"jira_group":                      resourceGroup(),
+			"jira_group_membership":           resourceGroupMembership(),
+			"jira_issue":                      resourceIssue(),
+			"jira_issue_attachment":           resourceIssueAttachment(),
+			"jira_issue_link":                 resourceIssueLink(),
+			"jira_issue_security_level":       resourceIssueSecurityLevel(),
+			"jira_issue_security_scheme":      resourceIssueSecurityScheme(),
+			"jira_issue_type":                 resourceIssueType(),
+			"jira_issue_type_scheme":          resourceIssueTypeScheme(),
+			"jira_issue_type_screen_scheme":   resourceIssueTypeScreenScheme(),
+			"jira_issue_watcher":              resourceIssueWatcher(),
+			"jira_issue_watchers":             resourceIssueWatchers(),
+			"jira_issue_link_type":            resourceIssueLinkType(),
+			"jira_notification_scheme":        resourceNotificationScheme(),
+			"jira_permission_scheme":          resourcePermissionScheme(),
+			"jira_priority":                   resourcePriority(),
+			"jira_priority_scheme":            resourcePriorityScheme(),
+			"jira_project":                    resourceProject(),
+			"jira_project_category":           resourceProjectCategory(),
+			"jira_project_membership":         resourceProjectMembership(),
+			"jira_webhook":                    resourceWebhook(),
+			"jira_workflow":                   resourceWorkflow(),
+			"jira_workflow_scheme":            resourceWorkflowScheme(),
+			"jira_resolution":                 resourceResolution(),
+			"jira_role":                       resourceRole(),
+			"jira_screen":                     resourceScreen(),
+			"jira_screen_scheme":              resourceScreenScheme(),
+			"jira_status":                     resourceStatus(),
+			"jira_user":                       resourceUser(),
+			"jira_version":                    resourceVersion(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"jira_field": resourceField(),
-			"jira_jql":   resourceJQL(),
+			"jira_field":       resourceField(),
+			"jira_issue_type":  dataSourceIssueType(),
+			"jira_issue_types": dataSourceIssueTypes(),
+			"jira_jql":         resourceJQL(),
+			"jira_priorities":  dataSourcePriorities(),
+			"jira_priority":    dataSourcePriority(),
+			"jira_project":     dataSourceProject(),
+			"jira_resolution":  dataSourceResolution(),
+			"jira_resolutions": dataSourceResolutions(),
+			"jira_status":      dataSourceStatus(),
+			"jira_statuses":    dataSourceStatuses(),
+			"jira_user":        dataSourceUser(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 }
 
 // providerConfigure configures the provider by creating and authenticating JIRA client
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var c Config
 	if err := c.createAndAuthenticateClient(d); err != nil {
-		return nil, errors.Wrap(err, "creating config failed")
+		return nil, diag.FromErr(errors.Wrap(err, "creating config failed"))
 	}
 	return &c, nil
 }
@@ -1,10 +1,13 @@
 package jira
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -17,6 +20,25 @@ func init() {
 	}
 }
 
+// TestMain runs the acceptance tests against an in-process fake of the Jira
+// API if JIRA_FAKE is set, so they don't require a Jira instance
+func TestMain(m *testing.M) {
+	if os.Getenv("JIRA_FAKE") == "" {
+		os.Exit(m.Run())
+	}
+
+	fake := newFakeJira()
+
+	os.Setenv("JIRA_URL", fake.URL)
+	os.Setenv("JIRA_USER", "admin")
+	os.Setenv("JIRA_PASSWORD", "admin")
+	os.Unsetenv("JIRA_TOKEN")
+
+	code := m.Run()
+	fake.Close()
+	os.Exit(code)
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -51,3 +73,40 @@ func testAccPreCheck(t *testing.T) {
 	}
 
 }
+
+// testAccStoreResourceID saves the ID of the named resource, so a subsequent
+// step can modify the resource outside of Terraform
+func testAccStoreResourceID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccStoreResourceAttr saves an attribute of the named resource, so a
+// subsequent step can compare it
+func testAccStoreResourceAttr(n string, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		v, ok := rs.Primary.Attributes[key]
+		if !ok {
+			return fmt.Errorf("%s: Attribute '%s' not found", n, key)
+		}
+
+		*value = v
+		return nil
+	}
+}
@@ -1,9 +1,13 @@
 package jira
 
 import (
-	"io/ioutil"
+	"context"
+	"encoding/json"
+	"fmt"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -11,18 +15,34 @@ import (
 // resourceComment is used to define a JIRA comment
 func resourceComment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCommentCreate,
-		Read:   resourceCommentRead,
-		Update: resourceCommentUpdate,
-		Delete: resourceCommentDelete,
+		CreateContext: resourceCommentCreate,
+		ReadContext:   resourceCommentRead,
+		UpdateContext: resourceCommentUpdate,
+		DeleteContext: resourceCommentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 
 		Description: "Creates a comment for an issue",
 
 		Schema: map[string]*schema.Schema{
 			"body": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The contents of the comment to be created",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"body", "body_adf"},
+				Description: "The contents of the comment to be created. With api_version 3, markdown and the " +
+					"headings, quotes and code blocks of wiki markup are converted into Atlassian Document Format",
+			},
+			"body_adf": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateADF,
+				DiffSuppressFunc: suppressEquivalentADF,
+				Description:      "The contents of the comment as JSON document in Atlassian Document Format. Requires api_version 3",
 			},
 			"issue_key": &schema.Schema{
 				Type:     schema.TypeString,
@@ -33,34 +53,101 @@ func resourceComment() *schema.Resource {
 	}
 }
 
+// commentAttributePath maps the fields rejected by JIRA to the attributes of jira_comment
+func commentAttributePath(field string) cty.Path {
+	if field == "body" {
+		return cty.GetAttrPath("body")
+	}
+	return nil
+}
+
+func commentEndpoint(issueKey string, id string) string {
+	return fmt.Sprintf("%s/comment/%s", issueEndpoint(issueKey), id)
+}
+
+func commentV3Endpoint(issueKey string, id string) string {
+	return fmt.Sprintf("%s/comment/%s", issueV3Endpoint(issueKey), id)
+}
+
+// commentV3Payload returns the request body of the comment for version 3 of
+// the API, which expects the body in Atlassian Document Format
+func commentV3Payload(d *schema.ResourceData) (map[string]interface{}, error) {
+	body, err := expandRichText(d, "body", "body_adf")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"body": body}, nil
+}
+
 // resourceCommentCreate creates a new jira comment using the jira api
-func resourceCommentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceCommentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	body := d.Get("body").(string)
 	issueKey := d.Get("issue_key").(string)
 
+	if diags := checkADFAPIVersion(config, d, "body_adf"); diags != nil {
+		return diags
+	}
+
+	if config.apiVersion == 3 {
+		payload, err := commentV3Payload(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		created := new(struct {
+			ID string `json:"id"`
+		})
+		if err := request(ctx, config.jiraClient, "POST", issueV3Endpoint(issueKey)+"/comment", payload, created); err != nil {
+			return errorDiagnostics(err, "creating jira comment failed", commentAttributePath)
+		}
+		d.SetId(created.ID)
+		return resourceCommentRead(ctx, d, m)
+	}
+
 	c := jira.Comment{Body: body}
 
-	comment, res, err := config.jiraClient.Issue.AddComment(issueKey, &c)
+	comment, res, err := config.jiraClient.Issue.AddCommentWithContext(ctx, issueKey, &c)
 
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "creating jira issue failed: %s", body)
+		return errorDiagnostics(newAPIError("POST", issueEndpoint(issueKey)+"/comment", res, err), "creating jira comment failed", commentAttributePath)
 	}
 
 	d.SetId(comment.ID)
 
-	return resourceCommentRead(d, m)
+	return resourceCommentRead(ctx, d, m)
 }
 
 // resourceCommentRead reads comment details using jira api
-func resourceCommentRead(d *schema.ResourceData, m interface{}) error {
+func resourceCommentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+
+	if config.apiVersion == 3 {
+		comment := new(struct {
+			Body json.RawMessage `json:"body"`
+		})
+		err := request(ctx, config.jiraClient, "GET", commentV3Endpoint(issueKey, d.Id()), nil, comment)
+		if err != nil {
+			if errors.Is(err, ResourceNotFoundError) {
+				d.SetId("")
+				return nil
+			}
+			return errorDiagnostics(err, "getting jira comment failed", nil)
+		}
+		if err := flattenRichText(d, "body", "body_adf", comment.Body); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
 
-	issue, res, err := config.jiraClient.Issue.Get(d.Get("issue_key").(string), nil)
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, issueKey, nil)
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "getting jira issue failed: %s", body)
+		err = newAPIError("GET", issueEndpoint(issueKey), res, err)
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "getting jira issue failed", nil)
 	}
 
 	var comment *jira.Comment
@@ -82,37 +169,51 @@ func resourceCommentRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceCommentUpdate updates jira comment using jira api
-func resourceCommentUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceCommentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	body := d.Get("body").(string)
 	issueKey := d.Get("issue_key").(string)
 
+	if diags := checkADFAPIVersion(config, d, "body_adf"); diags != nil {
+		return diags
+	}
+
+	if config.apiVersion == 3 {
+		payload, err := commentV3Payload(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := request(ctx, config.jiraClient, "PUT", commentV3Endpoint(issueKey, d.Id()), payload, nil); err != nil {
+			return errorDiagnostics(err, "updating jira comment failed", commentAttributePath)
+		}
+		return resourceCommentRead(ctx, d, m)
+	}
+
 	i := jira.Comment{
 		ID:   d.Id(),
 		Body: body,
 	}
 
-	comment, res, err := config.jiraClient.Issue.UpdateComment(issueKey, &i)
+	comment, res, err := config.jiraClient.Issue.UpdateCommentWithContext(ctx, issueKey, &i)
 
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "updating jira comment failed: %s", body)
+		return errorDiagnostics(newAPIError("PUT", commentEndpoint(issueKey, d.Id()), res, err), "updating jira comment failed", commentAttributePath)
 	}
 
 	d.SetId(comment.ID)
 
-	return resourceCommentRead(d, m)
+	return resourceCommentRead(ctx, d, m)
 }
 
 // resourceCommentDelete deletes jira comment using the jira api
-func resourceCommentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceCommentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	issueKey := d.Get("issue_key").(string)
 	id := d.Id()
 
-	err := config.jiraClient.Issue.DeleteComment(issueKey, id)
+	err := config.jiraClient.Issue.DeleteCommentWithContext(ctx, issueKey, id)
 	if err != nil {
-		return errors.Wrapf(err, "deleting jira comment failed")
+		return errorDiagnostics(err, "deleting jira comment failed", nil)
 	}
 	return nil
 }
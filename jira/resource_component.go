@@ -1,23 +1,32 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceComponent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComponentCreate,
-		Read:   resourceComponentRead,
-		Update: resourceComponentUpdate,
-		Delete: resourceComponentDelete,
+		CreateContext: resourceComponentCreate,
+		ReadContext:   resourceComponentRead,
+		UpdateContext: resourceComponentUpdate,
+		DeleteContext: resourceComponentDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Description: "Creates a project component",
@@ -70,7 +79,7 @@ func resourceComponent() *schema.Resource {
 
 }
 
-func resourceComponentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	client := config.jiraClient
 
@@ -81,28 +90,28 @@ func resourceComponentCreate(d *schema.ResourceData, m interface{}) error {
 	componentOptions.Project = d.Get("project_key").(string)
 	componentOptions.AssigneeType = strings.ToUpper(d.Get("assignee_type").(string))
 	componentOptions.LeadUserName = d.Get("lead").(string)
-	component, _, err := client.Component.Create(componentOptions)
+	component, _, err := client.Component.CreateWithContext(ctx, componentOptions)
 	if err != nil {
-		return err
+		return errorDiagnostics(err, "creating jira component failed", nil)
 	}
 	d.SetId(component.ID)
-	return resourceComponentRead(d, m)
+	return resourceComponentRead(ctx, d, m)
 }
 
-func resourceComponentRead(d *schema.ResourceData, m interface{}) error {
+func resourceComponentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	id := d.Id()
 	urlStr := fmt.Sprintf("%s/%s", componentAPIEndpoint, id)
 
 	component := &jira.ProjectComponent{}
 
-	err := request(config.jiraClient, "GET", urlStr, nil, component)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, component)
 	if err != nil {
 		if errors.Is(err, ResourceNotFoundError) {
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "reading jira component failed", nil)
 	}
 
 	d.SetId(component.ID)
@@ -115,7 +124,7 @@ func resourceComponentRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceComponentUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	id := d.Id()
 	urlStr := fmt.Sprintf("%s/%s", componentAPIEndpoint, id)
@@ -128,24 +137,24 @@ func resourceComponentUpdate(d *schema.ResourceData, m interface{}) error {
 	componentOptions.AssigneeType = strings.ToUpper(d.Get("assignee_type").(string))
 	componentOptions.LeadUserName = d.Get("lead").(string)
 
-	err := request(config.jiraClient, "PUT", urlStr, componentOptions, nil)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, componentOptions, nil)
 
 	if err != nil {
-		return err
+		return errorDiagnostics(err, "updating jira component failed", nil)
 	}
 
-	return resourceComponentRead(d, m)
+	return resourceComponentRead(ctx, d, m)
 }
 
-func resourceComponentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	id := d.Id()
 	urlStr := fmt.Sprintf("%s/%s", componentAPIEndpoint, id)
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return err
+		return errorDiagnostics(err, "deleting jira component failed", nil)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -49,7 +50,7 @@ func testAccCheckJiraComponentDestroy(s *terraform.State) error {
 
 		urlStr := fmt.Sprintf("%s/%s", componentAPIEndpoint, id)
 		component := &jira.ProjectComponent{}
-		err := request(client, "GET", urlStr, nil, component)
+		err := request(context.Background(), client, "GET", urlStr, nil, component)
 
 		if !errors.Is(err, ResourceNotFoundError) {
 			return fmt.Errorf("Component %q still exists", rs.Primary.ID)
@@ -78,7 +79,7 @@ func testAccCheckJiraComponentExists(n string) resource.TestCheckFunc {
 
 		urlStr := fmt.Sprintf("%s/%s", componentAPIEndpoint, id)
 		component := &jira.ProjectComponent{}
-		err := request(client, "GET", urlStr, nil, component)
+		err := request(context.Background(), client, "GET", urlStr, nil, component)
 
 		if errors.Is(err, ResourceNotFoundError) {
 			return fmt.Errorf("Component %q does not exists", id)
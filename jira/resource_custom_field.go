@@ -0,0 +1,189 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomFieldRequest The struct sent to the JIRA instance to create or update a custom field
+type CustomFieldRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type,omitempty"`
+	SearcherKey string `json:"searcherKey,omitempty"`
+}
+
+// CustomField is a field as returned by the field list. Only some versions
+// of JIRA include the description.
+type CustomField struct {
+	jira.Field
+	Description *string `json:"description,omitempty"`
+}
+
+// resourceCustomField is used to define a JIRA custom field
+func resourceCustomField() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomFieldCreate,
+		ReadContext:   resourceCustomFieldRead,
+		UpdateContext: resourceCustomFieldUpdate,
+		DeleteContext: resourceCustomFieldDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a custom field. The ID (for example customfield_10100) can be used as key in the fields of jira_issue",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the custom field",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the custom field",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the custom field (for example com.atlassian.jira.plugin.system.customfieldtypes:textfield)",
+			},
+			"searcher_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searcher of the custom field (for example com.atlassian.jira.plugin.system.customfieldtypes:textsearcher)",
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"clause_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func customFieldEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", fieldAPIEndpoint, id)
+}
+
+func setCustomField(w *CustomFieldRequest, d *schema.ResourceData) {
+	w.Name = d.Get("name").(string)
+	w.Description = d.Get("description").(string)
+	w.SearcherKey = d.Get("searcher_key").(string)
+}
+
+// resourceCustomFieldCreate creates a new jira custom field using the jira api
+func resourceCustomFieldCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	field := new(CustomFieldRequest)
+	setCustomField(field, d)
+	field.Type = d.Get("type").(string)
+
+	returnedField := new(jira.Field)
+	err := request(ctx, config.jiraClient, "POST", fieldAPIEndpoint, field, returnedField)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira custom field failed", nil)
+	}
+
+	// The field list changed, data sources have to fetch it again
+	setFieldsCache(nil)
+
+	d.SetId(returnedField.ID)
+
+	return resourceCustomFieldRead(ctx, d, m)
+}
+
+// getCustomField fetches the field list and returns the field with the given
+// ID, or nil if there is no such field. The fields cache is refreshed on the way.
+func getCustomField(ctx context.Context, client *jira.Client, id string) (*CustomField, error) {
+	var fields []CustomField
+	err := request(ctx, client, "GET", fieldAPIEndpoint, nil, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var field *CustomField
+	cache := make([]jira.Field, 0, len(fields))
+	for i := range fields {
+		cache = append(cache, fields[i].Field)
+		if fields[i].ID == id {
+			field = &fields[i]
+		}
+	}
+	setFieldsCache(cache)
+
+	return field, nil
+}
+
+// resourceCustomFieldRead reads custom field details using jira api
+func resourceCustomFieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	field, err := getCustomField(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field failed", nil)
+	}
+
+	if field == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", field.Name)
+	d.Set("type", field.Schema.Custom)
+	d.Set("key", field.Key)
+	d.Set("clause_names", field.ClauseNames)
+	if field.Description != nil {
+		d.Set("description", *field.Description)
+	}
+
+	return nil
+}
+
+// resourceCustomFieldUpdate updates jira custom field using jira api
+func resourceCustomFieldUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	field := new(CustomFieldRequest)
+	setCustomField(field, d)
+
+	err := request(ctx, config.jiraClient, "PUT", customFieldEndpoint(d.Id()), field, nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira custom field failed", nil)
+	}
+
+	setFieldsCache(nil)
+
+	return resourceCustomFieldRead(ctx, d, m)
+}
+
+// resourceCustomFieldDelete deletes jira custom field using the jira api
+func resourceCustomFieldDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", customFieldEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira custom field failed", nil)
+	}
+
+	setFieldsCache(nil)
+
+	return nil
+}
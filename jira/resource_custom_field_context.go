@@ -0,0 +1,467 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomFieldContextRequest The struct sent to the JIRA instance to create or update a custom field context
+type CustomFieldContextRequest struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	ProjectIDs   []string `json:"projectIds,omitempty"`
+	IssueTypeIDs []string `json:"issueTypeIds,omitempty"`
+}
+
+// CustomFieldContext is a context as returned by JIRA
+type CustomFieldContext struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	IsGlobalContext bool   `json:"isGlobalContext"`
+	IsAnyIssueType  bool   `json:"isAnyIssueType"`
+}
+
+// CustomFieldContextDefaultValue is the default value of a custom field in a
+// context. The attributes used depend on the type.
+type CustomFieldContextDefaultValue struct {
+	ContextID         string   `json:"contextId"`
+	Type              string   `json:"type"`
+	Text              *string  `json:"text,omitempty"`
+	URL               *string  `json:"url,omitempty"`
+	Number            *float64 `json:"number,omitempty"`
+	OptionID          string   `json:"optionId,omitempty"`
+	OptionIDs         []string `json:"optionIds,omitempty"`
+	CascadingOptionID string   `json:"cascadingOptionId,omitempty"`
+}
+
+// customFieldDefaultValueTypes maps the custom field types to the type of their
+// default value
+var customFieldDefaultValueTypes = map[string]string{
+	"com.atlassian.jira.plugin.system.customfieldtypes:textfield":       "textfield",
+	"com.atlassian.jira.plugin.system.customfieldtypes:textarea":        "textarea",
+	"com.atlassian.jira.plugin.system.customfieldtypes:url":             "url",
+	"com.atlassian.jira.plugin.system.customfieldtypes:float":           "float",
+	"com.atlassian.jira.plugin.system.customfieldtypes:select":          "option.single",
+	"com.atlassian.jira.plugin.system.customfieldtypes:radiobuttons":    "option.single",
+	"com.atlassian.jira.plugin.system.customfieldtypes:multiselect":     "option.multiple",
+	"com.atlassian.jira.plugin.system.customfieldtypes:multicheckboxes": "option.multiple",
+	"com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect": "option.cascading",
+}
+
+// resourceCustomFieldContext is used to define the context of a JIRA custom field
+func resourceCustomFieldContext() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomFieldContextCreate,
+		ReadContext:   resourceCustomFieldContextRead,
+		UpdateContext: resourceCustomFieldContextUpdate,
+		DeleteContext: resourceCustomFieldContextDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		// JIRA can't turn a global context into a project scoped one and vice versa
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("project_ids", isEmptySetChange),
+			customdiff.ForceNewIfChange("issue_type_ids", isEmptySetChange),
+			resourceCustomFieldContextCustomizeDiff,
+		),
+
+		Description: "Creates a context of a custom field, which defines the projects and issue types the field is available for. " +
+			"The ID has the format <field_id>:<context_id>",
+
+		Schema: map[string]*schema.Schema{
+			"field_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the custom field (for example customfield_10100)",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the context",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the context",
+			},
+			"project_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the projects the context applies to. The context is global, if no project is set",
+			},
+			"issue_type_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the issue types the context applies to. The context applies to all issue types, if none is set",
+			},
+			"default_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Default value of text, URL and number fields. " +
+					"The default of select lists is configured using jira_custom_field_option",
+			},
+			"context_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// isEmptySetChange reports whether a set changes from or to being empty
+func isEmptySetChange(ctx context.Context, old, new, meta interface{}) bool {
+	return (old.(*schema.Set).Len() == 0) != (new.(*schema.Set).Len() == 0)
+}
+
+func customFieldContextEndpoint(fieldID string) string {
+	return fmt.Sprintf("%s/%s/context", fieldAPIEndpoint, fieldID)
+}
+
+// splitCustomFieldContextID splits an ID of the format <field_id>:<context_id>
+func splitCustomFieldContextID(id string) (string, string, error) {
+	components := strings.SplitN(id, ":", 2)
+	if len(components) != 2 {
+		return "", "", fmt.Errorf("invalid ID %q, expected <field_id>:<context_id>", id)
+	}
+	return components[0], components[1], nil
+}
+
+// getCustomFieldContextDefault returns the default value of the context, or
+// nil if there is none
+func getCustomFieldContextDefault(ctx context.Context, client *jira.Client, fieldID string, contextID string) (*CustomFieldContextDefaultValue, error) {
+	query := url.Values{}
+	query.Set("contextId", contextID)
+
+	var defaultValue *CustomFieldContextDefaultValue
+	err := requestPages(ctx, client, customFieldContextEndpoint(fieldID)+"/defaultValue", query, func(value json.RawMessage) error {
+		defaultValue = new(CustomFieldContextDefaultValue)
+		return json.Unmarshal(value, defaultValue)
+	})
+	return defaultValue, err
+}
+
+// setCustomFieldContextDefault replaces the default value of a context.
+// Passing a value without any attributes removes the default.
+func setCustomFieldContextDefault(ctx context.Context, client *jira.Client, fieldID string, defaultValue *CustomFieldContextDefaultValue) error {
+	body := map[string]interface{}{
+		"defaultValues": []*CustomFieldContextDefaultValue{defaultValue},
+	}
+	return request(ctx, client, "PUT", customFieldContextEndpoint(fieldID)+"/defaultValue", body, nil)
+}
+
+// getCustomFieldDefaultValueType returns the type of the default value of a custom field
+func getCustomFieldDefaultValueType(ctx context.Context, client *jira.Client, fieldID string) (string, error) {
+	field, err := getCustomField(ctx, client, fieldID)
+	if err != nil {
+		return "", err
+	}
+	if field == nil {
+		return "", fmt.Errorf("custom field %s not found", fieldID)
+	}
+
+	defaultType, ok := customFieldDefaultValueTypes[field.Schema.Custom]
+	if !ok {
+		return "", fmt.Errorf("custom fields of type %s are not supported", field.Schema.Custom)
+	}
+	return defaultType, nil
+}
+
+// expandCustomFieldContextDefaultValue converts the default_value of a field
+// with the given default value type. An empty value removes the default.
+func expandCustomFieldContextDefaultValue(defaultType string, value string) (*CustomFieldContextDefaultValue, error) {
+	defaultValue := &CustomFieldContextDefaultValue{
+		Type: defaultType,
+	}
+
+	switch {
+	case strings.HasPrefix(defaultType, "option."):
+		return nil, fmt.Errorf("default_value is not supported for select lists, use the default attribute of jira_custom_field_option")
+	case value == "":
+		// Without a value, the default is removed
+	case defaultType == "url":
+		defaultValue.URL = &value
+	case defaultType == "float":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("default_value %q is not a number", value)
+		}
+		defaultValue.Number = &number
+	default:
+		defaultValue.Text = &value
+	}
+
+	return defaultValue, nil
+}
+
+// setCustomFieldContextDefaultValue sets the default_value of the context
+func setCustomFieldContextDefaultValue(ctx context.Context, client *jira.Client, d *schema.ResourceData) error {
+	fieldID := d.Get("field_id").(string)
+	defaultType, err := getCustomFieldDefaultValueType(ctx, client, fieldID)
+	if err != nil {
+		return err
+	}
+
+	defaultValue, err := expandCustomFieldContextDefaultValue(defaultType, d.Get("default_value").(string))
+	if err != nil {
+		return err
+	}
+	defaultValue.ContextID = d.Get("context_id").(string)
+
+	return setCustomFieldContextDefault(ctx, client, fieldID, defaultValue)
+}
+
+// resourceCustomFieldContextCustomizeDiff rejects default values the field
+// doesn't support, before the context is created
+func resourceCustomFieldContextCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	value := d.Get("default_value").(string)
+	if value == "" || !d.NewValueKnown("field_id") || !d.NewValueKnown("default_value") {
+		return nil
+	}
+
+	config := m.(*Config)
+	defaultType, err := getCustomFieldDefaultValueType(ctx, config.jiraClient, d.Get("field_id").(string))
+	if err != nil {
+		return err
+	}
+
+	_, err = expandCustomFieldContextDefaultValue(defaultType, value)
+	return err
+}
+
+// resourceCustomFieldContextCreate creates a new jira custom field context using the jira api
+func resourceCustomFieldContextCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	fieldID := d.Get("field_id").(string)
+
+	fieldContext := &CustomFieldContextRequest{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		ProjectIDs:   setToStrings(d.Get("project_ids").(*schema.Set)),
+		IssueTypeIDs: setToStrings(d.Get("issue_type_ids").(*schema.Set)),
+	}
+
+	// The default value is checked first, the field ID may have been unknown
+	// when the plan was validated
+	var defaultValue *CustomFieldContextDefaultValue
+	if value, ok := d.GetOk("default_value"); ok {
+		defaultType, err := getCustomFieldDefaultValueType(ctx, config.jiraClient, fieldID)
+		if err != nil {
+			return errorDiagnostics(err, "creating jira custom field context failed", nil)
+		}
+		defaultValue, err = expandCustomFieldContextDefaultValue(defaultType, value.(string))
+		if err != nil {
+			return errorDiagnostics(err, "creating jira custom field context failed", nil)
+		}
+	}
+
+	returnedContext := new(CustomFieldContext)
+	err := request(ctx, config.jiraClient, "POST", customFieldContextEndpoint(fieldID), fieldContext, returnedContext)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira custom field context failed", nil)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", fieldID, returnedContext.ID))
+	d.Set("context_id", returnedContext.ID)
+
+	if defaultValue != nil {
+		defaultValue.ContextID = returnedContext.ID
+		if err := setCustomFieldContextDefault(ctx, config.jiraClient, fieldID, defaultValue); err != nil {
+			return errorDiagnostics(err, "setting default value of jira custom field context failed", nil)
+		}
+	}
+
+	return resourceCustomFieldContextRead(ctx, d, m)
+}
+
+// resourceCustomFieldContextRead reads custom field context details using jira api
+func resourceCustomFieldContextRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	fieldID, contextID, err := splitCustomFieldContextID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field, err := getCustomField(ctx, config.jiraClient, fieldID)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field context failed", nil)
+	}
+	if field == nil {
+		d.SetId("")
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("contextId", contextID)
+
+	var fieldContext *CustomFieldContext
+	err = requestPages(ctx, config.jiraClient, customFieldContextEndpoint(fieldID), query, func(value json.RawMessage) error {
+		fieldContext = new(CustomFieldContext)
+		return json.Unmarshal(value, fieldContext)
+	})
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field context failed", nil)
+	}
+	if fieldContext == nil {
+		d.SetId("")
+		return nil
+	}
+
+	var projectIDs []string
+	err = requestPages(ctx, config.jiraClient, customFieldContextEndpoint(fieldID)+"/projectmapping", query, func(value json.RawMessage) error {
+		mapping := struct {
+			ProjectID string `json:"projectId"`
+		}{}
+		if err := json.Unmarshal(value, &mapping); err != nil {
+			return err
+		}
+		if mapping.ProjectID != "" {
+			projectIDs = append(projectIDs, mapping.ProjectID)
+		}
+		return nil
+	})
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field context projects failed", nil)
+	}
+
+	var issueTypeIDs []string
+	err = requestPages(ctx, config.jiraClient, customFieldContextEndpoint(fieldID)+"/issuetypemapping", query, func(value json.RawMessage) error {
+		mapping := struct {
+			IssueTypeID string `json:"issueTypeId"`
+		}{}
+		if err := json.Unmarshal(value, &mapping); err != nil {
+			return err
+		}
+		if mapping.IssueTypeID != "" {
+			issueTypeIDs = append(issueTypeIDs, mapping.IssueTypeID)
+		}
+		return nil
+	})
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field context issue types failed", nil)
+	}
+
+	// The defaults of select lists are managed by jira_custom_field_option
+	if defaultType := customFieldDefaultValueTypes[field.Schema.Custom]; !strings.HasPrefix(defaultType, "option.") {
+		defaultValue, err := getCustomFieldContextDefault(ctx, config.jiraClient, fieldID, contextID)
+		if err != nil {
+			return errorDiagnostics(err, "reading default value of jira custom field context failed", nil)
+		}
+
+		value := ""
+		if defaultValue != nil {
+			switch {
+			case defaultValue.Text != nil:
+				value = *defaultValue.Text
+			case defaultValue.URL != nil:
+				value = *defaultValue.URL
+			case defaultValue.Number != nil:
+				value = strconv.FormatFloat(*defaultValue.Number, 'f', -1, 64)
+			}
+		}
+		d.Set("default_value", value)
+	}
+
+	d.Set("field_id", fieldID)
+	d.Set("context_id", contextID)
+	d.Set("name", fieldContext.Name)
+	d.Set("description", fieldContext.Description)
+	d.Set("project_ids", projectIDs)
+	d.Set("issue_type_ids", issueTypeIDs)
+
+	return nil
+}
+
+// resourceCustomFieldContextUpdate updates jira custom field context using jira api
+func resourceCustomFieldContextUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	fieldID := d.Get("field_id").(string)
+	contextEndpoint := fmt.Sprintf("%s/%s", customFieldContextEndpoint(fieldID), d.Get("context_id"))
+
+	if d.HasChanges("name", "description") {
+		fieldContext := &CustomFieldContextRequest{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", contextEndpoint, fieldContext, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira custom field context failed", nil)
+		}
+	}
+
+	mappings := []struct {
+		attribute string
+		endpoint  string
+		key       string
+	}{
+		{"project_ids", contextEndpoint + "/project", "projectIds"},
+		{"issue_type_ids", contextEndpoint + "/issuetype", "issueTypeIds"},
+	}
+
+	for _, mapping := range mappings {
+		if !d.HasChange(mapping.attribute) {
+			continue
+		}
+
+		o, n := d.GetChange(mapping.attribute)
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if added := setToStrings(ns.Difference(os)); len(added) > 0 {
+			body := map[string][]string{mapping.key: added}
+			err := request(ctx, config.jiraClient, "PUT", mapping.endpoint, body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "updating jira custom field context failed", nil)
+			}
+		}
+
+		if removed := setToStrings(os.Difference(ns)); len(removed) > 0 {
+			body := map[string][]string{mapping.key: removed}
+			err := request(ctx, config.jiraClient, "POST", mapping.endpoint+"/remove", body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "updating jira custom field context failed", nil)
+			}
+		}
+	}
+
+	if d.HasChange("default_value") {
+		if err := setCustomFieldContextDefaultValue(ctx, config.jiraClient, d); err != nil {
+			return errorDiagnostics(err, "setting default value of jira custom field context failed", nil)
+		}
+	}
+
+	return resourceCustomFieldContextRead(ctx, d, m)
+}
+
+// resourceCustomFieldContextDelete deletes jira custom field context using the jira api
+func resourceCustomFieldContextDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	urlStr := fmt.Sprintf("%s/%s", customFieldContextEndpoint(d.Get("field_id").(string)), d.Get("context_id"))
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira custom field context failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraCustomFieldContext_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_custom_field_context.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraCustomFieldContextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraCustomFieldContextConfig(rInt, "foo", "jira_issue_type.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldContextExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "foo"),
+				),
+			},
+			{
+				Config: testAccJiraCustomFieldContextConfig(rInt, "bar", "jira_issue_type.foo.id, jira_issue_type.bar.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldContextExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomFieldContext_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_custom_field_context.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraCustomFieldContextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraCustomFieldContextConfig(rInt, "foo", "jira_issue_type.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					fieldID, contextID, err := splitCustomFieldContextID(id)
+					if err != nil {
+						t.Fatal(err)
+					}
+					urlStr := fmt.Sprintf("%s/%s", customFieldContextEndpoint(fieldID), contextID)
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err = request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraCustomFieldContextConfig(rInt, "foo", "jira_issue_type.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldContextExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomFieldContext_invalidDefault(t *testing.T) {
+	rInt := acctest.RandInt()
+	field := fmt.Sprintf(`
+resource "jira_custom_field" "foo" {
+  name = "foo-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+}
+`, rInt)
+	config := field + `
+resource "jira_custom_field_context" "foo" {
+  field_id      = jira_custom_field.foo.id
+  name          = "Default"
+  default_value = "A"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraCustomFieldContextDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The field ID is unknown during the plan, the default value
+				// is rejected before the context is created
+				Config:      config,
+				ExpectError: regexp.MustCompile(`default_value is not supported for select lists`),
+			},
+			{
+				// The field exists, the default value is rejected by the plan
+				PreConfig: func() {
+					if err := testAccCustomFieldWithoutContexts(fmt.Sprintf("foo-field-%d", rInt)); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`default_value is not supported for select lists`),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccCustomFieldWithoutContexts(fmt.Sprintf("foo-field-%d", rInt)); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: field,
+			},
+		},
+	})
+}
+
+// testAccCustomFieldWithoutContexts checks that no context of the field with
+// the given name exists
+func testAccCustomFieldWithoutContexts(name string) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	var fields []jira.Field
+	if err := request(context.Background(), client, "GET", fieldAPIEndpoint, nil, &fields); err != nil {
+		return err
+	}
+	field := findFieldByName(fields, name)
+	if field == nil {
+		return fmt.Errorf("Custom field %q not found", name)
+	}
+
+	count := 0
+	err := requestPages(context.Background(), client, customFieldContextEndpoint(field.ID), nil, func(json.RawMessage) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("Custom field %s has %d contexts", field.ID, count)
+	}
+	return nil
+}
+
+// testAccCustomFieldContextExists reports whether the context exists
+func testAccCustomFieldContextExists(id string) (bool, error) {
+	fieldID, contextID, err := splitCustomFieldContextID(id)
+	if err != nil {
+		return false, err
+	}
+
+	query := url.Values{}
+	query.Set("contextId", contextID)
+
+	client := testAccProvider.Meta().(*Config).jiraClient
+	exists := false
+	err = requestPages(context.Background(), client, customFieldContextEndpoint(fieldID), query, func(json.RawMessage) error {
+		exists = true
+		return nil
+	})
+	if errors.Is(err, ResourceNotFoundError) {
+		return false, nil
+	}
+	return exists, err
+}
+
+func testAccCheckJiraCustomFieldContextDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_custom_field_context" {
+			continue
+		}
+
+		exists, err := testAccCustomFieldContextExists(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Custom field context %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraCustomFieldContextExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No custom field context ID is set")
+		}
+
+		exists, err := testAccCustomFieldContextExists(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Custom field context %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraCustomFieldContextConfig(rInt int, name string, issueTypeIDs string) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = "${jira_user.foo.name}"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+}
+
+resource "jira_issue_type" "foo" {
+  name = "foo-type-%d"
+}
+
+resource "jira_issue_type" "bar" {
+  name = "bar-type-%d"
+}
+
+resource "jira_custom_field" "foo" {
+  name = "foo-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+}
+
+resource "jira_custom_field_context" "foo" {
+  field_id       = jira_custom_field.foo.id
+  name           = "%s"
+  description    = "Created by Terraform"
+  project_ids    = [jira_project.foo.project_id]
+  issue_type_ids = [%s]
+  default_value  = "%s"
+}
+`, rInt, rInt, rInt%100000, rInt, rInt, rInt, name, issueTypeIDs, name)
+}
@@ -0,0 +1,439 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// CustomFieldOption is an option of a select list. Child options of cascading
+// select lists reference their parent by OptionID.
+type CustomFieldOption struct {
+	ID       string `json:"id,omitempty"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+	OptionID string `json:"optionId,omitempty"`
+}
+
+// CustomFieldOptions is the body of the requests creating and updating options
+type CustomFieldOptions struct {
+	Options []CustomFieldOption `json:"options"`
+}
+
+// customFieldOptionConfig is an option as configured
+type customFieldOptionConfig struct {
+	value     string
+	disabled  bool
+	isDefault bool
+	children  []customFieldOptionConfig
+}
+
+// customFieldOptionSchema is the schema of an option block, which may contain
+// blocks for child options
+func customFieldOptionSchema(children bool) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Value of the option",
+		},
+		"disabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the option is disabled",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the option is selected by default",
+		},
+	}
+	if children {
+		s["child"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Child options of cascading select lists, in the order they are shown",
+			Elem:        customFieldOptionSchema(false),
+		}
+	}
+	return &schema.Resource{Schema: s}
+}
+
+// resourceCustomFieldOption is used to define the options of a JIRA custom field context
+func resourceCustomFieldOption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomFieldOptionCreate,
+		ReadContext:   resourceCustomFieldOptionRead,
+		UpdateContext: resourceCustomFieldOptionUpdate,
+		DeleteContext: resourceCustomFieldOptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Manages the options of a select list custom field in a context. " +
+			"Options are shown in the order of the option blocks, options not listed are removed. " +
+			"Options are matched by value, changing the value of an option replaces it. " +
+			"The ID has the format <field_id>:<context_id>",
+
+		Schema: map[string]*schema.Schema{
+			"field_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the custom field (for example customfield_10100)",
+			},
+			"context_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the context the options belong to",
+			},
+			"option": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Options in the order they are shown",
+				Elem:        customFieldOptionSchema(true),
+			},
+		},
+	}
+}
+
+func customFieldOptionEndpoint(fieldID string, contextID string) string {
+	return fmt.Sprintf("%s/%s/option", customFieldContextEndpoint(fieldID), contextID)
+}
+
+// expandCustomFieldOptions returns the options configured in a list of option blocks
+func expandCustomFieldOptions(list []interface{}) []customFieldOptionConfig {
+	options := make([]customFieldOptionConfig, 0, len(list))
+	for _, v := range list {
+		o := v.(map[string]interface{})
+		option := customFieldOptionConfig{
+			value:     o["value"].(string),
+			disabled:  o["disabled"].(bool),
+			isDefault: o["default"].(bool),
+		}
+		if children, ok := o["child"]; ok {
+			option.children = expandCustomFieldOptions(children.([]interface{}))
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// getCustomFieldOptions returns all options of a context in the order they are shown
+func getCustomFieldOptions(ctx context.Context, client *jira.Client, fieldID string, contextID string) ([]CustomFieldOption, error) {
+	var options []CustomFieldOption
+	err := requestPages(ctx, client, customFieldOptionEndpoint(fieldID, contextID), nil, func(value json.RawMessage) error {
+		var option CustomFieldOption
+		if err := json.Unmarshal(value, &option); err != nil {
+			return err
+		}
+		options = append(options, option)
+		return nil
+	})
+	return options, err
+}
+
+// syncCustomFieldOptions changes the options with the given parent to match
+// the configuration and returns their IDs in the configured order. Options
+// are matched by value. Renaming an option would change the value of all
+// issues using it, so options with a new value are created instead and
+// options which are no longer configured are deleted.
+func syncCustomFieldOptions(ctx context.Context, client *jira.Client, endpoint string, parentID string, existing []CustomFieldOption, configured []customFieldOptionConfig) ([]string, error) {
+	ids := make([]string, len(configured))
+
+	byValue := make(map[string]int)
+	for i, option := range existing {
+		byValue[option.Value] = i
+	}
+
+	used := make([]bool, len(existing))
+	var updates, creates []CustomFieldOption
+	for i, option := range configured {
+		j, ok := byValue[option.value]
+		if !ok || used[j] {
+			creates = append(creates, CustomFieldOption{Value: option.value, Disabled: option.disabled, OptionID: parentID})
+			continue
+		}
+
+		ids[i] = existing[j].ID
+		used[j] = true
+		if existing[j].Disabled != option.disabled {
+			updates = append(updates, CustomFieldOption{ID: existing[j].ID, Value: option.value, Disabled: option.disabled})
+		}
+	}
+
+	var unused []CustomFieldOption
+	for j, option := range existing {
+		if !used[j] {
+			unused = append(unused, option)
+		}
+	}
+
+	for _, option := range unused {
+		err := request(ctx, client, "DELETE", fmt.Sprintf("%s/%s", endpoint, option.ID), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updates) > 0 {
+		err := request(ctx, client, "PUT", endpoint, &CustomFieldOptions{Options: updates}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(creates) > 0 {
+		created := new(CustomFieldOptions)
+		err := request(ctx, client, "POST", endpoint, &CustomFieldOptions{Options: creates}, created)
+		if err != nil {
+			return nil, err
+		}
+		if len(created.Options) != len(creates) {
+			return nil, fmt.Errorf("%d options were created instead of %d", len(created.Options), len(creates))
+		}
+
+		for i := range ids {
+			if ids[i] == "" {
+				ids[i] = created.Options[0].ID
+				created.Options = created.Options[1:]
+			}
+		}
+	}
+
+	// New options are appended, so the order only matches if the existing
+	// options are in order and are followed by the new ones
+	var current []string
+	for _, option := range existing {
+		if containsString(ids, option.ID) {
+			current = append(current, option.ID)
+		}
+	}
+	for _, id := range ids {
+		if !containsString(current, id) {
+			current = append(current, id)
+		}
+	}
+
+	if len(ids) > 1 && !reflect.DeepEqual(current, ids) {
+		move := map[string]interface{}{
+			"customFieldOptionIds": ids,
+			"position":             "First",
+		}
+		err := request(ctx, client, "PUT", endpoint+"/move", move, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// setCustomFieldOptionDefaults sets the default value of the context to the
+// options configured as default
+func setCustomFieldOptionDefaults(ctx context.Context, client *jira.Client, fieldID string, contextID string, defaultType string, configured []customFieldOptionConfig, ids []string, childIDs [][]string) error {
+	defaultValue := &CustomFieldContextDefaultValue{
+		ContextID: contextID,
+		Type:      defaultType,
+	}
+
+	for i, option := range configured {
+		if option.isDefault {
+			defaultValue.OptionIDs = append(defaultValue.OptionIDs, ids[i])
+		}
+		for j, child := range option.children {
+			if !child.isDefault {
+				continue
+			}
+			if defaultType != "option.cascading" || !option.isDefault {
+				return fmt.Errorf("child option %q can only be the default of a cascading select list together with its parent", child.value)
+			}
+			if defaultValue.CascadingOptionID != "" {
+				return fmt.Errorf("only one child option can be the default")
+			}
+			defaultValue.CascadingOptionID = childIDs[i][j]
+		}
+	}
+
+	if defaultType != "option.multiple" {
+		if len(defaultValue.OptionIDs) > 1 {
+			return fmt.Errorf("only one option can be the default")
+		}
+		if len(defaultValue.OptionIDs) == 1 {
+			defaultValue.OptionID = defaultValue.OptionIDs[0]
+		}
+		defaultValue.OptionIDs = nil
+	}
+
+	return setCustomFieldContextDefault(ctx, client, fieldID, defaultValue)
+}
+
+// resourceCustomFieldOptionCreate creates the options of a custom field context using the jira api
+func resourceCustomFieldOptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("field_id"), d.Get("context_id")))
+
+	return resourceCustomFieldOptionUpdate(ctx, d, m)
+}
+
+// resourceCustomFieldOptionRead reads the options of a custom field context using jira api
+func resourceCustomFieldOptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	fieldID, contextID, err := splitCustomFieldContextID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options, err := getCustomFieldOptions(ctx, config.jiraClient, fieldID, contextID)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira custom field options failed", nil)
+	}
+
+	defaultValue, err := getCustomFieldContextDefault(ctx, config.jiraClient, fieldID, contextID)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field options failed", nil)
+	}
+
+	defaults := make(map[string]bool)
+	if defaultValue != nil {
+		for _, id := range append(defaultValue.OptionIDs, defaultValue.OptionID, defaultValue.CascadingOptionID) {
+			defaults[id] = true
+		}
+	}
+
+	flatten := func(option CustomFieldOption) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       option.ID,
+			"value":    option.Value,
+			"disabled": option.Disabled,
+			"default":  defaults[option.ID],
+		}
+	}
+
+	var list []interface{}
+	for _, option := range options {
+		if option.OptionID != "" {
+			continue
+		}
+
+		var children []interface{}
+		for _, child := range options {
+			if child.OptionID == option.ID {
+				children = append(children, flatten(child))
+			}
+		}
+
+		o := flatten(option)
+		o["child"] = children
+		list = append(list, o)
+	}
+
+	d.Set("field_id", fieldID)
+	d.Set("context_id", contextID)
+	d.Set("option", list)
+
+	return nil
+}
+
+// resourceCustomFieldOptionUpdate updates the options of a custom field context using jira api
+func resourceCustomFieldOptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	fieldID := d.Get("field_id").(string)
+	contextID := d.Get("context_id").(string)
+	endpoint := customFieldOptionEndpoint(fieldID, contextID)
+
+	defaultType, err := getCustomFieldDefaultValueType(ctx, config.jiraClient, fieldID)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira custom field options failed", nil)
+	}
+	if !strings.HasPrefix(defaultType, "option.") {
+		return diag.Errorf("custom field %s is not a select list", fieldID)
+	}
+
+	existing, err := getCustomFieldOptions(ctx, config.jiraClient, fieldID, contextID)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field options failed", nil)
+	}
+
+	children := func(parentID string) []CustomFieldOption {
+		var options []CustomFieldOption
+		for _, option := range existing {
+			if option.OptionID == parentID {
+				options = append(options, option)
+			}
+		}
+		return options
+	}
+
+	configured := expandCustomFieldOptions(d.Get("option").([]interface{}))
+	ids, err := syncCustomFieldOptions(ctx, config.jiraClient, endpoint, "", children(""), configured)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira custom field options failed", nil)
+	}
+
+	childIDs := make([][]string, len(configured))
+	for i, option := range configured {
+		childIDs[i], err = syncCustomFieldOptions(ctx, config.jiraClient, endpoint, ids[i], children(ids[i]), option.children)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira custom field options failed", nil)
+		}
+	}
+
+	err = setCustomFieldOptionDefaults(ctx, config.jiraClient, fieldID, contextID, defaultType, configured, ids, childIDs)
+	if err != nil {
+		return errorDiagnostics(err, "setting default value of jira custom field context failed", nil)
+	}
+
+	return resourceCustomFieldOptionRead(ctx, d, m)
+}
+
+// resourceCustomFieldOptionDelete deletes the options of a custom field context using the jira api
+func resourceCustomFieldOptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	fieldID := d.Get("field_id").(string)
+	contextID := d.Get("context_id").(string)
+
+	options, err := getCustomFieldOptions(ctx, config.jiraClient, fieldID, contextID)
+	if err != nil {
+		return errorDiagnostics(err, "reading jira custom field options failed", nil)
+	}
+
+	// Child options are deleted along with their parent
+	for _, option := range options {
+		if option.OptionID != "" {
+			continue
+		}
+		urlStr := fmt.Sprintf("%s/%s", customFieldOptionEndpoint(fieldID, contextID), option.ID)
+		err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+		if err != nil {
+			return errorDiagnostics(err, "deleting jira custom field option failed", nil)
+		}
+	}
+
+	return nil
+}
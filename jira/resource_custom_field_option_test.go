@@ -0,0 +1,226 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraCustomFieldOption_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_custom_field_option.foo"
+	var optionA, optionB, optionC, childA2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraCustomFieldOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraCustomFieldOptionConfig(rInt, `
+  option {
+    value   = "A"
+    default = true
+
+    child {
+      value = "A1"
+    }
+
+    child {
+      value   = "A2"
+      default = true
+    }
+  }
+
+  option {
+    value = "B"
+  }
+
+  option {
+    value = "C"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldOptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "option.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "option.0.child.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "option.0.default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "option.0.child.1.default", "true"),
+					testAccStoreResourceAttr(resourceName, "option.0.id", &optionA),
+					testAccStoreResourceAttr(resourceName, "option.1.id", &optionB),
+					testAccStoreResourceAttr(resourceName, "option.2.id", &optionC),
+					testAccStoreResourceAttr(resourceName, "option.0.child.1.id", &childA2),
+				),
+			},
+			{
+				// Reordering keeps the options
+				Config: testAccJiraCustomFieldOptionConfig(rInt, `
+  option {
+    value = "C"
+  }
+
+  option {
+    value = "A"
+
+    child {
+      value = "A2"
+    }
+
+    child {
+      value = "A1"
+    }
+  }
+
+  option {
+    value    = "B"
+    disabled = true
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "option.0.id", &optionC),
+					resource.TestCheckResourceAttrPtr(resourceName, "option.1.id", &optionA),
+					resource.TestCheckResourceAttrPtr(resourceName, "option.1.child.0.id", &childA2),
+					resource.TestCheckResourceAttr(resourceName, "option.1.default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "option.2.disabled", "true"),
+					resource.TestCheckResourceAttrPtr(resourceName, "option.2.id", &optionB),
+				),
+			},
+			{
+				// A new value replaces the option instead of renaming it
+				Config: testAccJiraCustomFieldOptionConfig(rInt, `
+  option {
+    value = "C"
+  }
+
+  option {
+    value = "A"
+
+    child {
+      value = "A2"
+    }
+
+    child {
+      value = "A1"
+    }
+  }
+
+  option {
+    value = "D"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "option.0.id", &optionC),
+					resource.TestCheckResourceAttrPtr(resourceName, "option.1.id", &optionA),
+					resource.TestCheckResourceAttr(resourceName, "option.2.value", "D"),
+					testAccCheckJiraCustomFieldOptionRemoved(resourceName, &optionB),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJiraCustomFieldOptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_custom_field_option" {
+			continue
+		}
+
+		fieldID, contextID, err := splitCustomFieldContextID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		options, err := getCustomFieldOptions(context.Background(), client, fieldID, contextID)
+		if err != nil && !errors.Is(err, ResourceNotFoundError) {
+			return err
+		}
+		if len(options) > 0 {
+			return fmt.Errorf("Custom field options %q still exist", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraCustomFieldOptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		fieldID, contextID, err := splitCustomFieldContextID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		options, err := getCustomFieldOptions(context.Background(), client, fieldID, contextID)
+		if err != nil {
+			return err
+		}
+		if len(options) == 0 {
+			return fmt.Errorf("Custom field options %q do not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckJiraCustomFieldOptionRemoved checks that the option with the
+// given ID no longer exists and isn't used by the resource
+func testAccCheckJiraCustomFieldOptionRemoved(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		fieldID, contextID, err := splitCustomFieldContextID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		options, err := getCustomFieldOptions(context.Background(), client, fieldID, contextID)
+		if err != nil {
+			return err
+		}
+		for _, option := range options {
+			if option.ID == *id {
+				return fmt.Errorf("Custom field option %s still exists with value %q", *id, option.Value)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccJiraCustomFieldOptionConfig(rInt int, options string) string {
+	return fmt.Sprintf(`
+resource "jira_custom_field" "foo" {
+  name = "foo-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect"
+}
+
+resource "jira_custom_field_context" "foo" {
+  field_id = jira_custom_field.foo.id
+  name     = "Default"
+}
+
+resource "jira_custom_field_option" "foo" {
+  field_id   = jira_custom_field.foo.id
+  context_id = jira_custom_field_context.foo.context_id
+%s
+}
+`, rInt, options)
+}
@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraCustomField_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_custom_field.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The data source must see the new field, although other
+				// lookups may already have filled the cache
+				Config: testAccJiraCustomFieldConfig(rInt, "foo") + testAccJiraCustomFieldLookupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-field-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "description", "foo"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "data.jira_field.foo", "id"),
+				),
+			},
+			{
+				Config: testAccJiraCustomFieldConfig(rInt, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The field list does not include the searcher
+				ImportStateVerifyIgnore: []string{"searcher_key"},
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomField_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_custom_field.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraCustomFieldConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", customFieldEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraCustomFieldConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraCustomFieldExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccFindCustomField looks up a field by ID in the field list
+func testAccFindCustomField(id string) (*jira.Field, error) {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	var fields []jira.Field
+	err := request(context.Background(), client, "GET", fieldAPIEndpoint, nil, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fields {
+		if fields[i].ID == id {
+			return &fields[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccCheckJiraCustomFieldDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_custom_field" {
+			continue
+		}
+
+		field, err := testAccFindCustomField(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if field != nil {
+			return fmt.Errorf("Custom field %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraCustomFieldExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No custom field ID is set")
+		}
+
+		field, err := testAccFindCustomField(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if field == nil {
+			return fmt.Errorf("Custom field %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraCustomFieldConfig(rInt int, description string) string {
+	return fmt.Sprintf(`
+resource "jira_custom_field" "foo" {
+  name         = "foo-field-%d"
+  description  = "%s"
+  type         = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+  searcher_key = "com.atlassian.jira.plugin.system.customfieldtypes:textsearcher"
+}
+`, rInt, description)
+}
+
+func testAccJiraCustomFieldLookupConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "jira_field" "foo" {
+  name = "foo-field-%d"
+
+  depends_on = [jira_custom_field.foo]
+}
+`, rInt)
+}
@@ -1,21 +1,39 @@
 package jira
 
 import (
-	"fmt"
+	"context"
+	"sync"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/pkg/errors"
 )
 
 var (
-	fieldsCache []jira.Field
+	// fieldsCache holds the field list, shared by the parallel operations of
+	// Terraform. It is guarded by fieldsCacheLock.
+	fieldsCache     []jira.Field
+	fieldsCacheLock sync.Mutex
 )
 
+func getFieldsCache() []jira.Field {
+	fieldsCacheLock.Lock()
+	defer fieldsCacheLock.Unlock()
+	return fieldsCache
+}
+
+// setFieldsCache replaces the cached field list. nil makes the next read
+// fetch the fields again.
+func setFieldsCache(fields []jira.Field) {
+	fieldsCacheLock.Lock()
+	defer fieldsCacheLock.Unlock()
+	fieldsCache = fields
+}
+
 // JIRA field
 func resourceField() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceFieldRead,
+		ReadContext: resourceFieldRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -51,21 +69,23 @@ func resourceField() *schema.Resource {
 	}
 }
 
-func resourceFieldRead(d *schema.ResourceData, m interface{}) error {
+func resourceFieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	name := d.Get("name").(string)
 
-	if fieldsCache == nil || len(fieldsCache) == 0 {
-		fields, _, err := config.jiraClient.Field.GetList()
+	fields := getFieldsCache()
+	if len(fields) == 0 {
+		var err error
+		fields, _, err = config.jiraClient.Field.GetListWithContext(ctx)
 		if err != nil {
-			return errors.Wrapf(err, "fetching jira fields failed")
+			return errorDiagnostics(err, "fetching jira fields failed", nil)
 		}
-		fieldsCache = fields
+		setFieldsCache(fields)
 	}
 
-	field := findFieldByName(fieldsCache, name)
+	field := findFieldByName(fields, name)
 	if field == nil {
-		return errors.New(fmt.Sprintf("field with name '%s' not found", name))
+		return diag.Errorf("field with name '%s' not found", name)
 	}
 
 	d.SetId(field.ID)
@@ -0,0 +1,282 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// FieldConfiguration The struct sent to and returned by the JIRA instance to manage field configurations
+type FieldConfiguration struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"isDefault,omitempty"`
+}
+
+// FieldConfigurationItem configures a field within a field configuration
+type FieldConfigurationItem struct {
+	ID          string `json:"id"`
+	IsHidden    bool   `json:"isHidden"`
+	IsRequired  bool   `json:"isRequired"`
+	Description string `json:"description"`
+	Renderer    string `json:"renderer,omitempty"`
+}
+
+// resourceFieldConfiguration is used to define a JIRA field configuration
+func resourceFieldConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFieldConfigurationCreate,
+		ReadContext:   resourceFieldConfigurationRead,
+		UpdateContext: resourceFieldConfigurationUpdate,
+		DeleteContext: resourceFieldConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a field configuration, which defines whether fields are required or hidden. " +
+			"The ID can be used in jira_field_configuration_scheme",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the field configuration",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the field configuration",
+			},
+			"field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "Configuration of a field. Fields without a block keep their defaults, " +
+					"fields whose block is removed are reset to their defaults",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the field, e.g. description or customfield_10000",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether a value needs to be set when creating or editing issues",
+						},
+						"hidden": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the field is hidden. Hidden fields can't be required",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the field shown on the issue forms",
+						},
+						"renderer": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"wiki-renderer", "text-renderer"}, false),
+							Description: "Renderer of text fields, wiki-renderer or text-renderer. " +
+								"The renderer is left unchanged if not set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fieldConfigurationEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", fieldConfigurationAPIEndpoint, id)
+}
+
+// getFieldConfiguration returns the field configuration, or nil if it does not exist
+func getFieldConfiguration(ctx context.Context, client *jira.Client, id string) (*FieldConfiguration, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	var configuration *FieldConfiguration
+	err := requestPages(ctx, client, fieldConfigurationAPIEndpoint, query, func(value json.RawMessage) error {
+		configuration = new(FieldConfiguration)
+		return json.Unmarshal(value, configuration)
+	})
+	return configuration, err
+}
+
+func expandFieldConfigurationItems(fields []interface{}) []FieldConfigurationItem {
+	items := make([]FieldConfigurationItem, 0, len(fields))
+	for _, v := range fields {
+		m := v.(map[string]interface{})
+		items = append(items, FieldConfigurationItem{
+			ID:          m["field_id"].(string),
+			IsRequired:  m["required"].(bool),
+			IsHidden:    m["hidden"].(bool),
+			Description: m["description"].(string),
+			Renderer:    m["renderer"].(string),
+		})
+	}
+	return items
+}
+
+// updateFieldConfigurationItems configures the fields in the field configuration
+func updateFieldConfigurationItems(ctx context.Context, client *jira.Client, id string, items []FieldConfigurationItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	body := map[string]interface{}{
+		"fieldConfigurationItems": items,
+	}
+	return request(ctx, client, "PUT", fieldConfigurationEndpoint(id)+"/fields", body, nil)
+}
+
+// resourceFieldConfigurationCreate creates a new jira field configuration using the jira api
+func resourceFieldConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	configuration := &FieldConfiguration{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	returnedConfiguration := new(FieldConfiguration)
+	err := request(ctx, config.jiraClient, "POST", fieldConfigurationAPIEndpoint, configuration, returnedConfiguration)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira field configuration failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedConfiguration.ID))
+
+	items := expandFieldConfigurationItems(d.Get("field").(*schema.Set).List())
+	if err := updateFieldConfigurationItems(ctx, config.jiraClient, d.Id(), items); err != nil {
+		return errorDiagnostics(err, "configuring fields of jira field configuration failed", nil)
+	}
+
+	return resourceFieldConfigurationRead(ctx, d, m)
+}
+
+// resourceFieldConfigurationRead reads field configuration details using jira api
+func resourceFieldConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	configuration, err := getFieldConfiguration(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira field configuration failed", nil)
+	}
+	if configuration == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Only the fields with a block are managed. The renderer is only read if
+	// it is managed, as JIRA reports a renderer for all text fields.
+	managed := make(map[string]FieldConfigurationItem)
+	for _, item := range expandFieldConfigurationItems(d.Get("field").(*schema.Set).List()) {
+		managed[item.ID] = item
+	}
+
+	fields := []map[string]interface{}{}
+	err = requestPages(ctx, config.jiraClient, fieldConfigurationEndpoint(d.Id())+"/fields", nil, func(value json.RawMessage) error {
+		item := new(FieldConfigurationItem)
+		if err := json.Unmarshal(value, item); err != nil {
+			return err
+		}
+		configured, ok := managed[item.ID]
+		if !ok {
+			return nil
+		}
+		renderer := ""
+		if configured.Renderer != "" {
+			renderer = item.Renderer
+		}
+		fields = append(fields, map[string]interface{}{
+			"field_id":    item.ID,
+			"required":    item.IsRequired,
+			"hidden":      item.IsHidden,
+			"description": item.Description,
+			"renderer":    renderer,
+		})
+		return nil
+	})
+	if err != nil {
+		return errorDiagnostics(err, "reading fields of jira field configuration failed", nil)
+	}
+
+	d.Set("name", configuration.Name)
+	d.Set("description", configuration.Description)
+	d.Set("field", fields)
+
+	return nil
+}
+
+// resourceFieldConfigurationUpdate updates jira field configuration using jira api
+func resourceFieldConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if d.HasChanges("name", "description") {
+		configuration := &FieldConfiguration{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", fieldConfigurationEndpoint(d.Id()), configuration, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira field configuration failed", nil)
+		}
+	}
+
+	if d.HasChange("field") {
+		o, n := d.GetChange("field")
+		items := expandFieldConfigurationItems(n.(*schema.Set).List())
+
+		configured := make(map[string]bool)
+		for _, item := range items {
+			configured[item.ID] = true
+		}
+		// Fields without a block are reset to their defaults
+		for _, item := range expandFieldConfigurationItems(o.(*schema.Set).List()) {
+			if !configured[item.ID] {
+				items = append(items, FieldConfigurationItem{ID: item.ID})
+			}
+		}
+
+		if err := updateFieldConfigurationItems(ctx, config.jiraClient, d.Id(), items); err != nil {
+			return errorDiagnostics(err, "configuring fields of jira field configuration failed", nil)
+		}
+	}
+
+	return resourceFieldConfigurationRead(ctx, d, m)
+}
+
+// resourceFieldConfigurationDelete deletes jira field configuration using the jira api
+func resourceFieldConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", fieldConfigurationEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira field configuration failed", nil)
+	}
+
+	return nil
+}
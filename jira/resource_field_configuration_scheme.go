@@ -0,0 +1,310 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// FieldConfigurationScheme The struct sent to and returned by the JIRA instance to manage field configuration schemes
+type FieldConfigurationScheme struct {
+	ID          string                            `json:"id,omitempty"`
+	Name        string                            `json:"name"`
+	Description string                            `json:"description"`
+	Mappings    []FieldConfigurationSchemeMapping `json:"-"`
+}
+
+// FieldConfigurationSchemeMapping maps an issue type to a field
+// configuration. The issue type default applies to all issue types without
+// mapping.
+type FieldConfigurationSchemeMapping struct {
+	IssueTypeID          string `json:"issueTypeId"`
+	FieldConfigurationID string `json:"fieldConfigurationId"`
+}
+
+// resourceFieldConfigurationScheme is used to define a JIRA field configuration scheme
+func resourceFieldConfigurationScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFieldConfigurationSchemeCreate,
+		ReadContext:   resourceFieldConfigurationSchemeRead,
+		UpdateContext: resourceFieldConfigurationSchemeUpdate,
+		DeleteContext: resourceFieldConfigurationSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a field configuration scheme, which maps issue types to field configurations. " +
+			"The ID can be used as field_configuration_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the field configuration scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the field configuration scheme",
+			},
+			"default_field_configuration_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the field configuration used by issue types without mapping. " +
+					"Defaults to the default field configuration",
+			},
+			"issue_type_mappings": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Maps the IDs of issue types to the IDs of their field configurations",
+			},
+		},
+	}
+}
+
+func fieldConfigurationSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", fieldConfigurationSchemeAPIEndpoint, id)
+}
+
+// expandFieldConfigurationSchemeMappings returns the mappings sorted by issue type
+func expandFieldConfigurationSchemeMappings(mappings map[string]string) []FieldConfigurationSchemeMapping {
+	result := make([]FieldConfigurationSchemeMapping, 0, len(mappings))
+	for issueTypeID, fieldConfigurationID := range mappings {
+		result = append(result, FieldConfigurationSchemeMapping{IssueTypeID: issueTypeID, FieldConfigurationID: fieldConfigurationID})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].IssueTypeID < result[j].IssueTypeID })
+	return result
+}
+
+// getFieldConfigurationScheme returns the field configuration scheme including
+// its mappings, or nil if it does not exist
+func getFieldConfigurationScheme(ctx context.Context, client *jira.Client, id string) (*FieldConfigurationScheme, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	var scheme *FieldConfigurationScheme
+	err := requestPages(ctx, client, fieldConfigurationSchemeAPIEndpoint, query, func(value json.RawMessage) error {
+		scheme = new(FieldConfigurationScheme)
+		return json.Unmarshal(value, scheme)
+	})
+	if err != nil || scheme == nil {
+		return nil, err
+	}
+
+	query = url.Values{}
+	query.Set("fieldConfigurationSchemeId", id)
+
+	err = requestPages(ctx, client, fieldConfigurationSchemeAPIEndpoint+"/mapping", query, func(value json.RawMessage) error {
+		mapping := FieldConfigurationSchemeMapping{}
+		if err := json.Unmarshal(value, &mapping); err != nil {
+			return err
+		}
+		scheme.Mappings = append(scheme.Mappings, mapping)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// getProjectFieldConfigurationScheme returns the field configuration scheme of
+// the project, or nil if the project uses the default field configuration
+func getProjectFieldConfigurationScheme(ctx context.Context, client *jira.Client, projectID string) (*FieldConfigurationScheme, error) {
+	query := url.Values{}
+	query.Set("projectId", projectID)
+
+	var scheme *FieldConfigurationScheme
+	err := requestPages(ctx, client, fieldConfigurationSchemeAPIEndpoint+"/project", query, func(value json.RawMessage) error {
+		association := new(struct {
+			FieldConfigurationScheme *FieldConfigurationScheme `json:"fieldConfigurationScheme"`
+		})
+		if err := json.Unmarshal(value, association); err != nil {
+			return err
+		}
+		scheme = association.FieldConfigurationScheme
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// assignProjectFieldConfigurationScheme makes the project use the field
+// configurations of the scheme
+func assignProjectFieldConfigurationScheme(ctx context.Context, client *jira.Client, projectID string, schemeID int) error {
+	body := map[string]string{
+		"fieldConfigurationSchemeId": strconv.Itoa(schemeID),
+		"projectId":                  projectID,
+	}
+	return request(ctx, client, "PUT", fieldConfigurationSchemeAPIEndpoint+"/project", body, nil)
+}
+
+// updateFieldConfigurationSchemeMappings adds or replaces the mappings of the scheme
+func updateFieldConfigurationSchemeMappings(ctx context.Context, client *jira.Client, id string, mappings []FieldConfigurationSchemeMapping) error {
+	if len(mappings) == 0 {
+		return nil
+	}
+	body := map[string]interface{}{
+		"mappings": mappings,
+	}
+	return request(ctx, client, "PUT", fieldConfigurationSchemeEndpoint(id)+"/mapping", body, nil)
+}
+
+// resourceFieldConfigurationSchemeCreate creates a new jira field configuration scheme using the jira api
+func resourceFieldConfigurationSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &FieldConfigurationScheme{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	returnedScheme := new(FieldConfigurationScheme)
+	err := request(ctx, config.jiraClient, "POST", fieldConfigurationSchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira field configuration scheme failed", nil)
+	}
+
+	d.SetId(returnedScheme.ID)
+
+	mappings := expandFieldConfigurationSchemeMappings(expandStringMap(d.Get("issue_type_mappings")))
+	if id, ok := d.GetOk("default_field_configuration_id"); ok {
+		mappings = append([]FieldConfigurationSchemeMapping{{
+			IssueTypeID:          "default",
+			FieldConfigurationID: strconv.Itoa(id.(int)),
+		}}, mappings...)
+	}
+	if err := updateFieldConfigurationSchemeMappings(ctx, config.jiraClient, d.Id(), mappings); err != nil {
+		return errorDiagnostics(err, "adding mappings of jira field configuration scheme failed", nil)
+	}
+
+	return resourceFieldConfigurationSchemeRead(ctx, d, m)
+}
+
+// resourceFieldConfigurationSchemeRead reads field configuration scheme details using jira api
+func resourceFieldConfigurationSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme, err := getFieldConfigurationScheme(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira field configuration scheme failed", nil)
+	}
+	if scheme == nil {
+		d.SetId("")
+		return nil
+	}
+
+	mappings := make(map[string]string)
+	for _, mapping := range scheme.Mappings {
+		if mapping.IssueTypeID == "default" {
+			id, _ := strconv.Atoi(mapping.FieldConfigurationID)
+			d.Set("default_field_configuration_id", id)
+			continue
+		}
+		mappings[mapping.IssueTypeID] = mapping.FieldConfigurationID
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("issue_type_mappings", mappings)
+
+	return nil
+}
+
+// resourceFieldConfigurationSchemeUpdate updates jira field configuration scheme using jira api
+func resourceFieldConfigurationSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	endpoint := fieldConfigurationSchemeEndpoint(d.Id())
+
+	if d.HasChanges("name", "description") {
+		scheme := &FieldConfigurationScheme{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", endpoint, scheme, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira field configuration scheme failed", nil)
+		}
+	}
+
+	mappings := []FieldConfigurationSchemeMapping{}
+	if d.HasChange("default_field_configuration_id") {
+		mappings = append(mappings, FieldConfigurationSchemeMapping{
+			IssueTypeID:          "default",
+			FieldConfigurationID: strconv.Itoa(d.Get("default_field_configuration_id").(int)),
+		})
+	}
+
+	if d.HasChange("issue_type_mappings") {
+		o, n := d.GetChange("issue_type_mappings")
+		oldMappings := expandStringMap(o)
+		newMappings := expandStringMap(n)
+
+		removed := []string{}
+		for issueTypeID := range oldMappings {
+			if _, ok := newMappings[issueTypeID]; !ok {
+				removed = append(removed, issueTypeID)
+			}
+		}
+		if len(removed) > 0 {
+			sort.Strings(removed)
+			body := map[string][]string{"issueTypeIds": removed}
+			err := request(ctx, config.jiraClient, "POST", endpoint+"/mapping/delete", body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "removing mappings of jira field configuration scheme failed", nil)
+			}
+		}
+
+		// Mappings of issue types which are already mapped are replaced
+		changed := make(map[string]string)
+		for issueTypeID, fieldConfigurationID := range newMappings {
+			if oldMappings[issueTypeID] != fieldConfigurationID {
+				changed[issueTypeID] = fieldConfigurationID
+			}
+		}
+		mappings = append(mappings, expandFieldConfigurationSchemeMappings(changed)...)
+	}
+
+	if err := updateFieldConfigurationSchemeMappings(ctx, config.jiraClient, d.Id(), mappings); err != nil {
+		return errorDiagnostics(err, "updating mappings of jira field configuration scheme failed", nil)
+	}
+
+	return resourceFieldConfigurationSchemeRead(ctx, d, m)
+}
+
+// resourceFieldConfigurationSchemeDelete deletes jira field configuration scheme using the jira api
+func resourceFieldConfigurationSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", fieldConfigurationSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira field configuration scheme failed", nil)
+	}
+
+	return nil
+}
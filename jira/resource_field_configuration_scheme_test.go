@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraFieldConfigurationScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_field_configuration_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraFieldConfigurationSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraFieldConfigurationSchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFieldConfigurationSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_field_configuration_id", "jira_field_configuration.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.%", "1"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "field_configuration_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraFieldConfigurationSchemeConfig(rInt, "bar", "bar", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFieldConfigurationSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_field_configuration_id", "jira_field_configuration.bar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.%", "1"),
+				),
+			},
+			{
+				// The issue type is mapped to the configuration foo, which
+				// requires a description
+				Config: testAccJiraFieldConfigurationSchemeConfig(rInt, "bar", "bar", "foo") + `
+resource "jira_issue" "bar" {
+  issue_type  = jira_issue_type.foo.name
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+}
+`,
+				ExpectError: regexp.MustCompile(`Description is required`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraFieldConfigurationScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_field_configuration_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraFieldConfigurationSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraFieldConfigurationSchemeBaseConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", fieldConfigurationSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraFieldConfigurationSchemeBaseConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFieldConfigurationSchemeExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "default_field_configuration_id", "jira_field_configuration.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraFieldConfigurationSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_field_configuration_scheme" {
+			continue
+		}
+
+		scheme, err := getFieldConfigurationScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme != nil {
+			return fmt.Errorf("Field configuration scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraFieldConfigurationSchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No field configuration scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		scheme, err := getFieldConfigurationScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme == nil {
+			return fmt.Errorf("Field configuration scheme %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// The scheme foo uses the field configuration defaultConfiguration by default
+// and maps the issue type to the field configuration mappedConfiguration. The
+// field configuration foo requires a description.
+func testAccJiraFieldConfigurationSchemeBaseConfig(rInt int, name string, defaultConfiguration string, mappedConfiguration string) string {
+	return fmt.Sprintf(`
+resource "jira_issue_type" "foo" {
+  name = "field-type-%d"
+}
+
+resource "jira_field_configuration" "foo" {
+  name = "foo-configuration-%d"
+
+  field {
+    field_id = "description"
+    required = true
+  }
+}
+
+resource "jira_field_configuration" "bar" {
+  name = "bar-configuration-%d"
+}
+
+resource "jira_field_configuration_scheme" "foo" {
+  name                           = "%s-scheme-%d"
+  description                    = "Created by Terraform"
+  default_field_configuration_id = jira_field_configuration.%s.id
+
+  issue_type_mappings = {
+    (jira_issue_type.foo.id) = jira_field_configuration.%s.id
+  }
+}
+`, rInt, rInt, rInt, name, rInt, defaultConfiguration, mappedConfiguration)
+}
+
+func testAccJiraFieldConfigurationSchemeConfig(rInt int, name string, defaultConfiguration string, mappedConfiguration string) string {
+	return testAccJiraFieldConfigurationSchemeBaseConfig(rInt, name, defaultConfiguration, mappedConfiguration) + fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                       = "foo-name-%d"
+  key                        = "PX%d"
+  lead                       = jira_user.foo.name
+  project_type_key           = "software"
+  project_template_key       = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  field_configuration_scheme = jira_field_configuration_scheme.foo.id
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = jira_issue_type.foo.name
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+  description = "Created using Terraform"
+}
+`, rInt, rInt, rInt%100000)
+}
@@ -0,0 +1,211 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraFieldConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_field_configuration.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraFieldConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraFieldConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFieldConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-configuration-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "field.*", map[string]string{
+						"field_id":    "description",
+						"required":    "true",
+						"description": "Describe the problem",
+						"renderer":    "text-renderer",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "field.*", map[string]string{
+						"field_id": "labels",
+						"hidden":   "true",
+					}),
+					testAccCheckJiraFieldConfigurationItem(resourceName, "description", true, false),
+				),
+			},
+			{
+				Config: testAccJiraFieldConfigurationUpdatedConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFieldConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-configuration-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "field.*", map[string]string{
+						"field_id": "labels",
+						"required": "true",
+						"hidden":   "false",
+					}),
+					// Fields whose block was removed are reset
+					testAccCheckJiraFieldConfigurationItem(resourceName, "description", false, false),
+				),
+			},
+			{
+				// Which fields are managed is only known from the configuration
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"field"},
+			},
+		},
+	})
+}
+
+func TestAccJiraFieldConfiguration_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_field_configuration.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraFieldConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraFieldConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", fieldConfigurationEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraFieldConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFieldConfigurationExists(resourceName),
+					testAccCheckJiraFieldConfigurationItem(resourceName, "description", true, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraFieldConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_field_configuration" {
+			continue
+		}
+
+		configuration, err := getFieldConfiguration(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if configuration != nil {
+			return fmt.Errorf("Field configuration %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraFieldConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No field configuration ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		configuration, err := getFieldConfiguration(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if configuration == nil {
+			return fmt.Errorf("Field configuration %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckJiraFieldConfigurationItem checks the flags of a field as
+// reported by JIRA
+func testAccCheckJiraFieldConfigurationItem(n string, fieldID string, required bool, hidden bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		var found *FieldConfigurationItem
+		err := requestPages(context.Background(), client, fieldConfigurationEndpoint(rs.Primary.ID)+"/fields", nil, func(value json.RawMessage) error {
+			item := new(FieldConfigurationItem)
+			if err := json.Unmarshal(value, item); err != nil {
+				return err
+			}
+			if item.ID == fieldID {
+				found = item
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("Field %s is not part of field configuration %q", fieldID, rs.Primary.ID)
+		}
+		if found.IsRequired != required || found.IsHidden != hidden {
+			return fmt.Errorf("Field %s is required=%t hidden=%t, expected required=%t hidden=%t",
+				fieldID, found.IsRequired, found.IsHidden, required, hidden)
+		}
+		return nil
+	}
+}
+
+func testAccJiraFieldConfigurationConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_field_configuration" "foo" {
+  name        = "foo-configuration-%d"
+  description = "Created by Terraform"
+
+  field {
+    field_id    = "description"
+    required    = true
+    description = "Describe the problem"
+    renderer    = "text-renderer"
+  }
+
+  field {
+    field_id = "labels"
+    hidden   = true
+  }
+}
+`, rInt)
+}
+
+func testAccJiraFieldConfigurationUpdatedConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_field_configuration" "foo" {
+  name = "bar-configuration-%d"
+
+  field {
+    field_id = "labels"
+    required = true
+  }
+}
+`, rInt)
+}
@@ -2,12 +2,13 @@ package jira
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 
 	jira "github.com/andygrunwald/go-jira"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -51,12 +52,18 @@ type FilterPermissionResult struct {
 // resourceFilter is used to define a JIRA Filter
 func resourceFilter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceFilterCreate,
-		Read:   resourceFilterRead,
-		Update: resourceFilterUpdate,
-		Delete: resourceFilterDelete,
+		CreateContext: resourceFilterCreate,
+		ReadContext:   resourceFilterRead,
+		UpdateContext: resourceFilterUpdate,
+		DeleteContext: resourceFilterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -185,7 +192,7 @@ func setFilterResource(w *jira.Filter, d *schema.ResourceData) {
 }
 
 // resourceFilterCreate creates a new jira filter using the jira api
-func resourceFilterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	filter := new(FilterRequest)
@@ -193,34 +200,36 @@ func resourceFilterCreate(d *schema.ResourceData, m interface{}) error {
 	returnedFilter := new(jira.Filter)
 	setFilter(filter, d)
 
-	err := request(config.jiraClient, "POST", filterAPIEndpoint, filter, returnedFilter)
+	err := request(ctx, config.jiraClient, "POST", filterAPIEndpoint, filter, returnedFilter)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira filter failed", nil)
 	}
 
 	setFilterResource(returnedFilter, d)
 
-	err = filterAddPermissions(permissions.List(), returnedFilter.ID, config)
+	err = filterAddPermissions(ctx, permissions.List(), returnedFilter.ID, config)
 	if err != nil {
-		return err
+		return errorDiagnostics(err, "creating jira filter failed", nil)
 	}
 
-	setFilterResource(returnedFilter, d)
-
-	return nil
+	return resourceFilterRead(ctx, d, m)
 }
 
 // resourceFilterRead reads filter details using jira api
-func resourceFilterRead(d *schema.ResourceData, m interface{}) error {
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", filterAPIEndpoint, d.Id())
 
 	filter := new(jira.Filter)
-	err := request(config.jiraClient, "GET", urlStr, nil, filter)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, filter)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira filter failed", nil)
 	}
 
 	setFilterResource(filter, d)
@@ -229,7 +238,7 @@ func resourceFilterRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceFilterUpdate updates jira filter using jira api
-func resourceFilterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	if d.HasChange("permissions") {
@@ -244,14 +253,14 @@ func resourceFilterUpdate(d *schema.ResourceData, m interface{}) error {
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		err := filterRevokePermissions(os.Difference(ns).List(), d.Id(), config)
+		err := filterRevokePermissions(ctx, os.Difference(ns).List(), d.Id(), config)
 		if err != nil {
-			return err
+			return errorDiagnostics(err, "updating jira filter failed", nil)
 		}
 
-		err = filterAddPermissions(ns.Difference(os).List(), d.Id(), config)
+		err = filterAddPermissions(ctx, ns.Difference(os).List(), d.Id(), config)
 		if err != nil {
-			return err
+			return errorDiagnostics(err, "updating jira filter failed", nil)
 		}
 	}
 
@@ -267,25 +276,25 @@ func resourceFilterUpdate(d *schema.ResourceData, m interface{}) error {
 	urlStr := fmt.Sprintf("%s/%s", filterAPIEndpoint, d.Id())
 	returnedFilter := new(jira.Filter)
 
-	err := request(config.jiraClient, "PUT", urlStr, filter, returnedFilter)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, filter, returnedFilter)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira filter failed", nil)
 	}
 
-	return resourceFilterRead(d, m)
+	return resourceFilterRead(ctx, d, m)
 }
 
 // resourceFilterDelete deletes jira filter using the jira api
-func resourceFilterDelete(d *schema.ResourceData, m interface{}) error {
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", filterAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira filter failed", nil)
 	}
 
 	return nil
@@ -314,12 +323,13 @@ func resourceFilterPermissionsHash(v interface{}) int {
 	return HashString(buf.String())
 }
 
-func filterRevokePermissions(configured []interface{}, filterID string, config *Config) error {
+func filterRevokePermissions(ctx context.Context, configured []interface{}, filterID string, config *Config) error {
 	for _, data := range configured {
 		d := data.(map[string]interface{})
 		url := fmt.Sprintf("%s/%s", filterPermissionEndpoint(filterID), d["id"].(string))
 
 		err := request(
+			ctx,
 			config.jiraClient,
 			"DELETE",
 			url,
@@ -332,7 +342,7 @@ func filterRevokePermissions(configured []interface{}, filterID string, config *
 	return nil
 }
 
-func filterAddPermissions(configured []interface{}, filterID string, config *Config) error {
+func filterAddPermissions(ctx context.Context, configured []interface{}, filterID string, config *Config) error {
 
 	for _, data := range configured {
 		d := data.(map[string]interface{})
@@ -343,6 +353,7 @@ func filterAddPermissions(configured []interface{}, filterID string, config *Con
 			ProjectRoleID: d["project_role_id"].(string),
 		}
 		err := request(
+			ctx,
 			config.jiraClient,
 			"POST",
 			filterPermissionEndpoint(filterID),
@@ -0,0 +1,124 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraFilter_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_filter.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraFilterConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraFilter_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_filter.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraFilterConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					urlStr := fmt.Sprintf("%s/%s", filterAPIEndpoint, id)
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraFilterConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraFilterExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraFilterDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_filter" {
+			continue
+		}
+
+		urlStr := fmt.Sprintf("%s/%s", filterAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Filter %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraFilterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No filter ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		urlStr := fmt.Sprintf("%s/%s", filterAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if err != nil {
+			return fmt.Errorf("Filter %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraFilterConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_filter" "foo" {
+  name        = "foo-filter-%d"
+  description = "Created by Terraform"
+  jql         = "assignee = currentUser() ORDER BY updated DESC"
+
+  permissions {
+    type = "authenticated"
+  }
+}`, rInt)
+}
@@ -1,8 +1,10 @@
 package jira
 
 import (
+	"context"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -15,11 +17,16 @@ type GroupRequest struct {
 // resourceGroup is used to define a JIRA issue
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupCreate,
-		Read:   resourceGroupRead,
-		Delete: resourceGroupDelete,
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		DeleteContext: resourceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -32,29 +39,34 @@ func resourceGroup() *schema.Resource {
 }
 
 // resourceGroupCreate creates a new jira issue using the jira api
-func resourceGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	group := new(GroupRequest)
 	group.Name = d.Get("name").(string)
 
-	err := request(config.jiraClient, "POST", groupAPIEndpoint, group, nil)
+	err := request(ctx, config.jiraClient, "POST", groupAPIEndpoint, group, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira group failed", nil)
 	}
 
 	d.SetId(group.Name)
 
-	return resourceGroupRead(d, m)
+	return resourceGroupRead(ctx, d, m)
 }
 
 // resourceGroupRead reads issue details using jira api
-func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
-	_, _, err := config.jiraClient.Group.Get(d.Id())
+	_, res, err := config.jiraClient.Group.GetWithContext(ctx, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "getting jira group failed")
+		err = newAPIError("GET", groupAPIEndpoint, res, err)
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira group failed", nil)
 	}
 
 	d.Set("name", d.Id())
@@ -63,7 +75,7 @@ func resourceGroupRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceGroupDelete deletes jira issue using the jira api
-func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	relativeURL, _ := url.Parse(groupAPIEndpoint)
@@ -73,9 +85,9 @@ func resourceGroupDelete(d *schema.ResourceData, m interface{}) error {
 
 	relativeURL.RawQuery = query.Encode()
 
-	err := request(config.jiraClient, "DELETE", relativeURL.String(), nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", relativeURL.String(), nil, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira group failed", nil)
 	}
 
 	return nil
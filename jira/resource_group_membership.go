@@ -1,11 +1,13 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -25,7 +27,7 @@ type UserGroups struct {
 	Groups Groups `json:"groups,omitempty" structs:"groups,omitempty"`
 }
 
-func getGroups(jiraClient *jira.Client, username string) (*UserGroups, *jira.Response, error) {
+func getGroups(ctx context.Context, jiraClient *jira.Client, username string) (*UserGroups, *jira.Response, error) {
 
 	relativeURL, _ := url.Parse("/rest/api/2/user")
 	query := relativeURL.Query()
@@ -34,7 +36,7 @@ func getGroups(jiraClient *jira.Client, username string) (*UserGroups, *jira.Res
 
 	relativeURL.RawQuery = query.Encode()
 
-	req, err := jiraClient.NewRequest("GET", relativeURL.String(), nil)
+	req, err := jiraClient.NewRequestWithContext(ctx, "GET", relativeURL.String(), nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -42,7 +44,7 @@ func getGroups(jiraClient *jira.Client, username string) (*UserGroups, *jira.Res
 	user := new(UserGroups)
 	resp, err := jiraClient.Do(req, user)
 	if err != nil {
-		return nil, resp, jira.NewJiraError(resp, err)
+		return nil, resp, newAPIError("GET", relativeURL.String(), resp, err)
 	}
 	return user, resp, nil
 }
@@ -50,11 +52,16 @@ func getGroups(jiraClient *jira.Client, username string) (*UserGroups, *jira.Res
 // resourceGroupMembership is used to define a JIRA issue
 func resourceGroupMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGroupMembershipCreate,
-		Read:   resourceGroupMembershipRead,
-		Delete: resourceGroupMembershipDelete,
+		CreateContext: resourceGroupMembershipCreate,
+		ReadContext:   resourceGroupMembershipRead,
+		DeleteContext: resourceGroupMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 		Schema: map[string]*schema.Schema{
 			"username": &schema.Schema{
@@ -72,7 +79,7 @@ func resourceGroupMembership() *schema.Resource {
 }
 
 // resourceGroupMembershipCreate creates a new jira issue using the jira api
-func resourceGroupMembershipCreate(d *schema.ResourceData, m interface{}) error {
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	username := d.Get("username").(string)
@@ -86,27 +93,31 @@ func resourceGroupMembershipCreate(d *schema.ResourceData, m interface{}) error
 	query.Set("groupname", group)
 	relativeURL.RawQuery = query.Encode()
 
-	err := request(config.jiraClient, "POST", relativeURL.String(), groupMembership, nil)
+	err := request(ctx, config.jiraClient, "POST", relativeURL.String(), groupMembership, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira group membership failed", nil)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", username, group))
 
-	return resourceGroupMembershipRead(d, m)
+	return resourceGroupMembershipRead(ctx, d, m)
 }
 
 // resourceGroupMembershipRead reads issue details using jira api
-func resourceGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	components := strings.SplitN(d.Id(), ":", 2)
 	username := components[0]
 	groupname := components[1]
 
-	groups, _, err := getGroups(config.jiraClient, username)
+	groups, _, err := getGroups(ctx, config.jiraClient, username)
 	if err != nil {
-		return errors.Wrap(err, "getting jira group failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira group membership failed", nil)
 	}
 
 	d.Set("username", username)
@@ -118,11 +129,13 @@ func resourceGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	return errors.Errorf("Cannot find group %s", groupname)
+	// The user is no longer a member of the group
+	d.SetId("")
+	return nil
 }
 
 // resourceGroupMembershipDelete deletes jira issue using the jira api
-func resourceGroupMembershipDelete(d *schema.ResourceData, m interface{}) error {
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	relativeURL, _ := url.Parse(groupUserAPIEndpoint)
@@ -133,9 +146,9 @@ func resourceGroupMembershipDelete(d *schema.ResourceData, m interface{}) error
 
 	relativeURL.RawQuery = query.Encode()
 
-	err := request(config.jiraClient, "DELETE", relativeURL.String(), nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", relativeURL.String(), nil, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira group membership failed", nil)
 	}
 
 	return nil
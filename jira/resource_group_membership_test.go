@@ -0,0 +1,151 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraGroupMembership_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_group_membership.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraGroupMembershipConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraGroupMembershipExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraGroupMembership_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_group_membership.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraGroupMembershipConfig(rInt),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := removeTestGroupMembership(jiraClient, fmt.Sprintf("member-%d", rInt), fmt.Sprintf("group-%d", rInt))
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraGroupMembershipConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraGroupMembershipExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func removeTestGroupMembership(client *jira.Client, username, group string) error {
+	query := url.Values{}
+	query.Set("username", username)
+	query.Set("groupname", group)
+
+	urlStr := fmt.Sprintf("%s?%s", groupUserAPIEndpoint, query.Encode())
+	return request(context.Background(), client, "DELETE", urlStr, nil, nil)
+}
+
+func isTestGroupMember(client *jira.Client, id string) (bool, error) {
+	components := strings.SplitN(id, ":", 2)
+
+	groups, _, err := getGroups(context.Background(), client, components[0])
+	if err != nil {
+		return false, err
+	}
+
+	for _, group := range groups.Groups.Items {
+		if group.Name == components[1] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccCheckJiraGroupMembershipDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_group_membership" {
+			continue
+		}
+
+		// the user is usually destroyed along with the membership, which
+		// makes the lookup fail as well
+		member, _ := isTestGroupMember(client, rs.Primary.ID)
+		if member {
+			return fmt.Errorf("Group membership %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraGroupMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No group membership ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		member, err := isTestGroupMember(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !member {
+			return fmt.Errorf("Group membership %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraGroupMembershipConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "member-%d"
+  email = "example@example.org"
+}
+
+resource "jira_group" "foo" {
+  name = "group-%d"
+}
+
+resource "jira_group_membership" "foo" {
+  username = "${jira_user.foo.name}"
+  group    = "${jira_group.foo.name}"
+}`, rInt, rInt)
+}
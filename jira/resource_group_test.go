@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -34,6 +35,36 @@ func TestAccJiraGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraGroup_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_group.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraGroupConfig(rInt),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					urlStr := fmt.Sprintf("%s?groupname=foo-name-%d", groupAPIEndpoint, rInt)
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraGroupExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckJiraGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Config).jiraClient
 
@@ -1,10 +1,21 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	"github.com/trivago/tgo/tcontainer"
@@ -13,12 +24,19 @@ import (
 // resourceIssue is used to define a JIRA issue
 func resourceIssue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIssueCreate,
-		Read:   resourceIssueRead,
-		Update: resourceIssueUpdate,
-		Delete: resourceIssueDelete,
+		CreateContext: resourceIssueCreate,
+		ReadContext:   resourceIssueRead,
+		UpdateContext: resourceIssueUpdate,
+		DeleteContext: resourceIssueDelete,
+		CustomizeDiff: resourceIssueCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			State: resourceIssueImport,
+			StateContext: resourceIssueImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,14 +63,33 @@ func resourceIssue() *schema.Resource {
 					Required: true,
 				},
 			},
+			"fields_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateIssueFieldsJSON,
+				DiffSuppressFunc: suppressEquivalentIssueFieldsJSON,
+				Description: "JSON object of field values in the format of the JIRA REST API, e.g. " +
+					"{\"customfield_10000\": {\"value\": \"High\"}}. Supports select lists, user pickers, dates, " +
+					"cascading selects and arrays. Keys JIRA adds to the values, like self or id, are ignored",
+			},
 			"issue_type": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"description": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{"description_adf"},
+				Description: "Description of the issue. With api_version 3, markdown and the headings, quotes and code " +
+					"blocks of wiki markup are converted into Atlassian Document Format",
+			},
+			"description_adf": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateADF,
+				DiffSuppressFunc: suppressEquivalentADF,
+				Description:      "Description of the issue as JSON document in Atlassian Document Format. Requires api_version 3",
 			},
 			"labels": &schema.Schema{
 				Type:     schema.TypeList,
@@ -81,21 +118,408 @@ func resourceIssue() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"status": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"state", "state_transition"},
+				DiffSuppressFunc: caseInsensitiveSuppressFunc,
+				Description: "Name of the status of the issue. The issue is moved along the transitions of its workflow, " +
+					"passing intermediate statuses if there is no direct transition. Without the permission to read the workflow, " +
+					"the transitions are explored one by one, so the issue may pass through further intermediate statuses",
+			},
+			"resolution": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if new == "" {
+						return true
+					}
+					return caseInsensitiveSuppressFunc(k, old, new, d)
+				},
+				Description: "Name of the resolution set by transitions which ask for one, e.g. when moving the issue to Done. " +
+					"Changing only the resolution requires a transition from the status of the issue back into it",
+			},
+			"transition_comment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comment added by the last transition when the issue is moved into status",
+			},
 			"delete_transition": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"priority": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: caseInsensitiveSuppressFunc,
+				Description:      "Name of the priority of the issue. Defaults to the default priority of the priority scheme of the project",
+			},
+			"components": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the components of the project the issue belongs to",
+			},
+			"fix_versions": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the versions of the project the issue is fixed in",
+			},
+			"affects_versions": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the versions of the project affected by the issue",
+			},
+			"due_date": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Due date of the issue (for example 2021-05-31)",
+			},
+			"environment": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Environment the issue occurs in. With api_version 3, it is converted into Atlassian Document Format like description",
+			},
+			"original_estimate": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIssueEstimate,
+				Description:  "Original estimate of the issue, e.g. 1w 2d 4h 30m. Requires time tracking to be enabled",
+			},
+			"remaining_estimate": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIssueEstimate,
+				Description:  "Remaining estimate of the issue, e.g. 4h. Defaults to the original estimate when the issue is created",
+			},
+			"parent": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key of the parent issue, of sub-tasks or, on Jira Cloud, of issues in an epic. JIRA doesn't remove the parent of sub-tasks",
+			},
+			"security_level": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the issue security level (for example the level_id of jira_issue_security_level). " +
+					"Defaults to the default level of the issue security scheme. Set to an empty string to make the issue visible to everyone",
+			},
 			// Computed values
 			"issue_key": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"original_estimate_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Original estimate of the issue in seconds, based on the working days and hours configured in JIRA",
+			},
+			"remaining_estimate_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Remaining estimate of the issue in seconds, based on the working days and hours configured in JIRA",
+			},
 		},
 	}
 }
 
+// issueAttributePath maps the fields rejected by JIRA to the attributes of jira_issue
+func issueAttributePath(field string) cty.Path {
+	switch field {
+	case "assignee", "reporter", "description", "labels", "summary", "priority", "components", "environment", "parent":
+		return cty.GetAttrPath(field)
+	case "fixVersions":
+		return cty.GetAttrPath("fix_versions")
+	case "versions":
+		return cty.GetAttrPath("affects_versions")
+	case "duedate":
+		return cty.GetAttrPath("due_date")
+	case "timetracking":
+		return cty.GetAttrPath("original_estimate")
+	case "issuetype":
+		return cty.GetAttrPath("issue_type")
+	case "project":
+		return cty.GetAttrPath("project_key")
+	case "security":
+		return cty.GetAttrPath("security_level")
+	}
+	return cty.GetAttrPath("fields").IndexString(field)
+}
+
+// issueFieldsJSONAttributePath maps the fields rejected by JIRA to fields_json
+// if they are set there, and to the other attributes of jira_issue otherwise
+func issueFieldsJSONAttributePath(fieldsJSON map[string]interface{}) func(string) cty.Path {
+	return func(field string) cty.Path {
+		if _, ok := fieldsJSON[field]; ok {
+			return cty.GetAttrPath("fields_json")
+		}
+		return issueAttributePath(field)
+	}
+}
+
+// expandIssueFieldsJSON decodes the field values of fields_json
+func expandIssueFieldsJSON(v interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if s, _ := v.(string); s != "" {
+		if err := json.Unmarshal([]byte(s), &fields); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+func validateIssueFieldsJSON(v interface{}, k string) ([]string, []error) {
+	if _, err := expandIssueFieldsJSON(v); err != nil {
+		return nil, []error{fmt.Errorf("%s needs to be a JSON object of field values: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// issueFieldValueContains reports whether the value returned by JIRA matches
+// the configured value. Keys JIRA adds to objects are ignored, the order of
+// array elements doesn't matter.
+func issueFieldValueContains(actual, configured interface{}) bool {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range c {
+			if !issueFieldValueContains(a[k], v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(c) {
+			return false
+		}
+		matched := make([]bool, len(a))
+		for _, v := range c {
+			found := false
+			for i := range a {
+				if !matched[i] && issueFieldValueContains(a[i], v) {
+					matched[i], found = true, true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(actual, configured)
+}
+
+// pruneIssueFieldValue removes the keys JIRA added to the configured value
+// from the value returned by JIRA
+func pruneIssueFieldValue(actual, configured interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		pruned := make(map[string]interface{})
+		for k, v := range c {
+			if value, ok := a[k]; ok {
+				pruned[k] = pruneIssueFieldValue(value, v)
+			}
+		}
+		return pruned
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return actual
+		}
+		pruned := make([]interface{}, 0, len(a))
+		for _, value := range a {
+			for _, v := range c {
+				if issueFieldValueContains(value, v) {
+					value = pruneIssueFieldValue(value, v)
+					break
+				}
+			}
+			pruned = append(pruned, value)
+		}
+		return pruned
+	}
+	return actual
+}
+
+// suppressEquivalentIssueFieldsJSON suppresses differences in formatting and
+// keys JIRA adds to field values
+func suppressEquivalentIssueFieldsJSON(k, old, new string, d *schema.ResourceData) bool {
+	actual, err := expandIssueFieldsJSON(old)
+	if err != nil {
+		return false
+	}
+	configured, err := expandIssueFieldsJSON(new)
+	if err != nil || len(actual) != len(configured) {
+		return false
+	}
+	for field, value := range configured {
+		if _, ok := actual[field]; !ok || !issueFieldValueContains(actual[field], value) {
+			return false
+		}
+	}
+	return true
+}
+
+var issueEstimate = regexp.MustCompile(`^\s*(\d+(\.\d+)?\s*[wdhm]\s*)+$`)
+
+// validateIssueEstimate checks the notation of estimates like 1w 2d 4h 30m.
+// Their duration depends on the working days and hours configured in JIRA.
+func validateIssueEstimate(v interface{}, k string) ([]string, []error) {
+	if !issueEstimate.MatchString(v.(string)) {
+		return nil, []error{fmt.Errorf("%s: %q is not an estimate like 1w 2d 4h 30m", k, v)}
+	}
+	return nil, nil
+}
+
+// setIssueEstimate sets the estimate and its duration in seconds. JIRA
+// normalizes estimates, e.g. 90m into 1h 30m, so the notation in the state
+// is kept as long as JIRA reports the same duration for it.
+func setIssueEstimate(d *schema.ResourceData, key string, estimate string, seconds int) {
+	if d.Get(key).(string) == "" || d.Get(key+"_seconds").(int) != seconds {
+		d.Set(key, estimate)
+	}
+	d.Set(key+"_seconds", seconds)
+}
+
+// setAppliedIssueEstimateSeconds records the durations JIRA computed for the
+// estimates sent by create or update, keeping their notation in the state
+func setAppliedIssueEstimateSeconds(d *schema.ResourceData, issue *jira.Issue, update bool) {
+	if issue.Fields.TimeTracking == nil {
+		return
+	}
+	seconds := map[string]int{
+		"original_estimate":  issue.Fields.TimeTracking.OriginalEstimateSeconds,
+		"remaining_estimate": issue.Fields.TimeTracking.RemainingEstimateSeconds,
+	}
+	for key, value := range seconds {
+		if d.Get(key).(string) != "" && (!update || d.HasChange(key)) {
+			d.Set(key+"_seconds", value)
+		}
+	}
+}
+
+// expandIssueStandardFields sets the standard fields without a suitable
+// counterpart in jira.IssueFields. Updates only send changed fields, removed
+// values are cleared.
+func expandIssueStandardFields(d *schema.ResourceData, i *jira.Issue, update bool) {
+	if i.Fields.Unknowns == nil {
+		i.Fields.Unknowns = tcontainer.NewMarshalMap()
+	}
+	changed := func(key string) bool {
+		return !update || d.HasChange(key)
+	}
+
+	if priority := d.Get("priority").(string); priority != "" && changed("priority") {
+		i.Fields.Unknowns["priority"] = map[string]interface{}{"name": priority}
+	}
+
+	for key, field := range map[string]string{"components": "components", "fix_versions": "fixVersions", "affects_versions": "versions"} {
+		names := d.Get(key).(*schema.Set).List()
+		if changed(key) && (update || len(names) > 0) {
+			values := make([]interface{}, 0, len(names))
+			for _, name := range names {
+				values = append(values, map[string]interface{}{"name": name})
+			}
+			i.Fields.Unknowns[field] = values
+		}
+	}
+
+	for key, field := range map[string]string{"due_date": "duedate", "environment": "environment"} {
+		if value := d.Get(key).(string); value != "" && changed(key) {
+			i.Fields.Unknowns[field] = value
+		} else if value == "" && update && d.HasChange(key) {
+			i.Fields.Unknowns[field] = nil
+		}
+	}
+
+	timetracking := make(map[string]interface{})
+	for key, field := range map[string]string{"original_estimate": "originalEstimate", "remaining_estimate": "remainingEstimate"} {
+		if value := d.Get(key).(string); value != "" && changed(key) {
+			timetracking[field] = value
+		}
+	}
+	if original := d.Get("original_estimate").(string); original == "" && update && d.HasChange("original_estimate") {
+		timetracking["originalEstimate"] = ""
+	}
+	if len(timetracking) > 0 {
+		i.Fields.Unknowns["timetracking"] = timetracking
+	}
+
+	if parent := d.Get("parent").(string); parent != "" && changed("parent") {
+		i.Fields.Unknowns["parent"] = map[string]interface{}{"key": parent}
+	} else if parent == "" && update && d.HasChange("parent") {
+		i.Fields.Unknowns["parent"] = nil
+	}
+}
+
+// issueV3Payload returns the request body of the issue for version 3 of the
+// API, which expects the description in Atlassian Document Format. Updates
+// send null to clear a removed description.
+func issueV3Payload(d *schema.ResourceData, i *jira.Issue, update bool) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(i.Fields)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+
+	description, err := expandRichText(d, "description", "description_adf")
+	if err != nil {
+		return nil, err
+	}
+	delete(fields, "description")
+	if description != nil || update {
+		fields["description"] = description
+	}
+	if environment, ok := fields["environment"].(string); ok {
+		fields["environment"] = markupToADF(environment)
+	}
+
+	return map[string]interface{}{"fields": fields}, nil
+}
+
+// isEmptyStringConfig reports whether the attribute is explicitly set to an
+// empty string. The SDK treats empty strings like missing values, so this is
+// the only way to tell them apart for computed attributes.
+func isEmptyStringConfig(config cty.Value, attribute string) bool {
+	if !config.IsKnown() || config.IsNull() {
+		return false
+	}
+	value := config.GetAttr(attribute)
+	return value.IsKnown() && !value.IsNull() && value.AsString() == ""
+}
+
+// resourceIssueCustomizeDiff plans the removal of the security level, if the
+// configuration sets it to an empty string. Otherwise the level assigned by
+// JIRA is kept. The SDK drops empty values of computed attributes from the
+// plan, so the new value is planned as computed.
+func resourceIssueCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if old, _ := d.GetChange("security_level"); old.(string) != "" && isEmptyStringConfig(d.GetRawConfig(), "security_level") {
+		return d.SetNewComputed("security_level")
+	}
+	return nil
+}
+
 // resourceIssueCreate creates a new jira issue using the jira api
-func resourceIssueCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	assignee := d.Get("assignee")
 	reporter := d.Get("reporter")
@@ -146,43 +570,377 @@ func resourceIssueCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	issue, res, err := config.jiraClient.Issue.Create(&i)
+	fieldsJSON, err := expandIssueFieldsJSON(d.Get("fields_json"))
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "creating jira issue failed: %s", body)
+		return diag.FromErr(err)
+	}
+	if len(fieldsJSON) > 0 {
+		if i.Fields.Unknowns == nil {
+			i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		}
+		for field, value := range fieldsJSON {
+			i.Fields.Unknowns[field] = value
+		}
 	}
 
-	issue, res, err = config.jiraClient.Issue.Get(issue.ID, nil)
+	expandIssueStandardFields(d, &i, false)
+
+	if securityLevel, ok := d.GetOk("security_level"); ok {
+		if i.Fields.Unknowns == nil {
+			i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		}
+		i.Fields.Unknowns.Set("security", map[string]interface{}{"id": securityLevel.(string)})
+	} else if isEmptyStringConfig(d.GetRawConfig(), "security_level") {
+		// Without a level, JIRA would assign the default level of the scheme
+		if i.Fields.Unknowns == nil {
+			i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		}
+		i.Fields.Unknowns["security"] = nil
+	}
+
+	if diags := checkADFAPIVersion(config, d, "description_adf"); diags != nil {
+		return diags
+	}
+
+	if config.apiVersion == 3 {
+		payload, err := issueV3Payload(d, &i, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		created := new(jira.Issue)
+		if err := request(ctx, config.jiraClient, "POST", issueV3APIEndpoint, payload, created); err != nil {
+			return errorDiagnostics(err, "creating jira issue failed", issueFieldsJSONAttributePath(fieldsJSON))
+		}
+		d.SetId(created.ID)
+	} else {
+		issue, res, err := config.jiraClient.Issue.CreateWithContext(ctx, &i)
+		if err != nil {
+			return errorDiagnostics(newAPIError("POST", issueAPIEndpoint, res, err), "creating jira issue failed", issueFieldsJSONAttributePath(fieldsJSON))
+		}
+		d.SetId(issue.ID)
+	}
+
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, d.Id(), nil)
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "getting jira issue failed: %s", body)
+		return errorDiagnostics(newAPIError("GET", issueEndpoint(d.Id()), res, err), "getting jira issue failed", nil)
+	}
+	setAppliedIssueEstimateSeconds(d, issue, false)
+
+	if diags := resourceIssueTransition(ctx, d, config, issue); diags.HasError() {
+		return diags
+	}
+
+	return resourceIssueRead(ctx, d, m)
+}
+
+// resourceIssueTransition moves the issue into the configured state or status
+func resourceIssueTransition(ctx context.Context, d *schema.ResourceData, config *Config, issue *jira.Issue) diag.Diagnostics {
+	if status, ok := d.GetOk("status"); ok && !strings.EqualFold(issue.Fields.Status.Name, status.(string)) {
+		err := transitionIssueToStatus(ctx, config.jiraClient, issue, status.(string), d.Get("resolution").(string), d.Get("transition_comment").(string))
+		if err != nil {
+			return errorDiagnostics(err, "transitioning jira issue failed", nil)
+		}
+		return nil
+	}
+
+	if resolution, ok := d.GetOk("resolution"); ok && (issue.Fields.Resolution == nil || !strings.EqualFold(issue.Fields.Resolution.Name, resolution.(string))) {
+		err := resolveIssue(ctx, config.jiraClient, issue, resolution.(string), d.Get("transition_comment").(string))
+		if err != nil {
+			return errorDiagnostics(err, "resolving jira issue failed", nil)
+		}
+		return nil
 	}
 
 	if state, ok := d.GetOk("state"); ok {
 		if issue.Fields.Status.ID != state.(string) {
 			if transition, ok := d.GetOk("state_transition"); ok {
-				res, err := config.jiraClient.Issue.DoTransition(issue.ID, transition.(string))
+				res, err := config.jiraClient.Issue.DoTransitionWithContext(ctx, issue.ID, transition.(string))
 				if err != nil {
-					body, _ := ioutil.ReadAll(res.Body)
-					return errors.Wrapf(err, "transitioning jira issue failed: %s", body)
+					return errorDiagnostics(newAPIError("POST", issueTransitionsEndpoint(issue.ID), res, err), "transitioning jira issue failed", nil)
 				}
 			}
 		}
 	}
+	return nil
+}
+
+// errIssueWorkflowUnknown is returned if JIRA doesn't report the workflow of
+// an issue, e.g. for team-managed projects
+var errIssueWorkflowUnknown = errors.New("the workflow of the issue is unknown")
+
+// maxExploredIssueTransitions limits the transitions performed while exploring
+// the workflow of an issue
+const maxExploredIssueTransitions = 20
+
+// isIssueWorkflowUnreadable reports whether the workflow of an issue can't be
+// read, either for lack of permission or because JIRA doesn't report it
+func isIssueWorkflowUnreadable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+		return true
+	}
+	return errors.Is(err, errIssueWorkflowUnknown)
+}
+
+// issueWorkflowName returns the name of the workflow of the issue
+func issueWorkflowName(ctx context.Context, client *jira.Client, issue *jira.Issue) (string, error) {
+	scheme, err := getProjectWorkflowScheme(ctx, client, issue.Fields.Project.ID)
+	if err != nil {
+		return "", err
+	}
+	if scheme == nil {
+		return "", errors.Wrapf(errIssueWorkflowUnknown, "no workflow scheme is reported for project %s", issue.Fields.Project.Key)
+	}
+	if name, ok := scheme.IssueTypeMappings[issue.Fields.Type.ID]; ok {
+		return name, nil
+	}
+	if scheme.DefaultWorkflow != "" {
+		return scheme.DefaultWorkflow, nil
+	}
+	return "jira", nil
+}
+
+// planIssueStatusPath returns the IDs of the statuses the issue passes on the
+// shortest way through its workflow to the status with the given name,
+// starting with its current status. It returns nil if there is no way.
+func planIssueStatusPath(ctx context.Context, client *jira.Client, issue *jira.Issue, target string) ([]string, error) {
+	name, err := issueWorkflowName(ctx, client, issue)
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := getWorkflow(ctx, client, name)
+	if errors.Is(err, ResourceNotFoundError) {
+		// Jira Data Center doesn't provide the workflow search
+		return nil, errors.Wrapf(errIssueWorkflowUnknown, "searching workflow %q failed: %s", name, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, errors.Wrapf(errIssueWorkflowUnknown, "workflow %q not found", name)
+	}
+	statuses, err := listStatuses(ctx, client, "")
+	if err != nil {
+		return nil, err
+	}
+	statusNames := make(map[string]string)
+	for _, status := range statuses {
+		statusNames[status.ID] = status.Name
+	}
+
+	// Breadth-first search from the current status. Transitions without
+	// from statuses are global and can be taken from every status.
+	previous := map[string]string{issue.Fields.Status.ID: ""}
+	queue := []string{issue.Fields.Status.ID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if strings.EqualFold(statusNames[current], target) {
+			path := []string{}
+			for id := current; id != ""; id = previous[id] {
+				path = append([]string{id}, path...)
+			}
+			return path, nil
+		}
+
+		for _, transition := range workflow.Transitions {
+			if transition.Type == "initial" || (len(transition.From) > 0 && !containsString(transition.From, current)) {
+				continue
+			}
+			if _, ok := previous[transition.To]; !ok {
+				previous[transition.To] = current
+				queue = append(queue, transition.To)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// transitionIssueToStatus moves the issue into the status with the given name.
+// If no transition leads there directly, the issue is moved along the
+// shortest path through its workflow, or explores the workflow if it can't be
+// read. The resolution is passed to the transitions asking for one, the
+// comment is added by the last transition.
+func transitionIssueToStatus(ctx context.Context, client *jira.Client, issue *jira.Issue, target string, resolution string, comment string) error {
+	current := issue.Fields.Status
+
+	// Reading the workflow requires administrative permissions. Without it,
+	// the workflow is explored along the transitions available for the issue.
+	path, err := planIssueStatusPath(ctx, client, issue, target)
+	if err != nil && !isIssueWorkflowUnreadable(err) {
+		return err
+	}
+	explore := err != nil
+	if explore {
+		log.Printf("[WARN] Reading the workflow of issue %s failed, exploring its transitions towards %q instead: %s", issue.Key, target, err)
+	}
+
+	explored := make(map[string][]jira.Transition)
+	for steps := 0; !strings.EqualFold(current.Name, target); steps++ {
+		if (!explore && steps > len(path)) || (explore && steps >= maxExploredIssueTransitions) {
+			return errors.Errorf("issue %s did not reach status %q, it is in status %q", issue.Key, target, current.Name)
+		}
+
+		available, err := getIssueTransitions(ctx, client, issue.ID)
+		if err != nil {
+			return err
+		}
+		explored[current.ID] = available
+
+		var transition *jira.Transition
+		if explore {
+			transition = nextExploredIssueTransition(explored, current.ID, target)
+		} else {
+			transition = nextPlannedIssueTransition(available, path, current.ID, target)
+		}
+		if transition == nil {
+			return errors.Errorf("no transition of issue %s leads from status %q towards %q", issue.Key, current.Name, target)
+		}
+
+		transitionComment := ""
+		if strings.EqualFold(transition.To.Name, target) {
+			transitionComment = comment
+		}
+		if err := doIssueTransition(ctx, client, issue, transition, resolution, transitionComment); err != nil {
+			return err
+		}
+		current = &transition.To
+	}
+	return nil
+}
+
+// nextPlannedIssueTransition returns the available transition leading to the
+// target status, or else to the status following the current one on the path
+func nextPlannedIssueTransition(available []jira.Transition, path []string, statusID string, target string) *jira.Transition {
+	next := ""
+	for i, id := range path {
+		if id == statusID && i+1 < len(path) {
+			next = path[i+1]
+		}
+	}
+
+	var transition *jira.Transition
+	for i, t := range available {
+		if strings.EqualFold(t.To.Name, target) {
+			return &available[i]
+		}
+		if next != "" && t.To.ID == next && transition == nil {
+			transition = &available[i]
+		}
+	}
+	return transition
+}
+
+// nextExploredIssueTransition returns the first transition of the shortest
+// path through the explored statuses, which leads either to the target status
+// or to a status whose transitions are not known yet. Following these
+// transitions explores the workflow until the target status is reached.
+func nextExploredIssueTransition(explored map[string][]jira.Transition, statusID string, target string) *jira.Transition {
+	for i, t := range explored[statusID] {
+		if strings.EqualFold(t.To.Name, target) {
+			return &explored[statusID][i]
+		}
+	}
+
+	// Breadth-first search, remembering the first transition leading to each
+	// status
+	first := map[string]*jira.Transition{statusID: nil}
+	queue := []string{statusID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for i := range explored[current] {
+			transition := &explored[current][i]
+			if _, ok := first[transition.To.ID]; ok {
+				continue
+			}
+			first[transition.To.ID] = first[current]
+			if current == statusID {
+				first[transition.To.ID] = transition
+			}
+
+			if _, ok := explored[transition.To.ID]; !ok || strings.EqualFold(transition.To.Name, target) {
+				return first[transition.To.ID]
+			}
+			queue = append(queue, transition.To.ID)
+		}
+	}
+	return nil
+}
+
+// resolveIssue changes the resolution of the issue, keeping its status. JIRA
+// sets resolutions only in transitions, so a transition from the status of
+// the issue back into it asking for a resolution is required.
+func resolveIssue(ctx context.Context, client *jira.Client, issue *jira.Issue, resolution string, comment string) error {
+	available, err := getIssueTransitions(ctx, client, issue.ID)
+	if err != nil {
+		return err
+	}
+
+	for i, t := range available {
+		if _, ok := t.Fields["resolution"]; ok && t.To.ID == issue.Fields.Status.ID {
+			return doIssueTransition(ctx, client, issue, &available[i], resolution, comment)
+		}
+	}
+	return errors.Errorf("no transition of issue %s leads from status %q back into it setting a resolution, the resolution can only be changed together with the status", issue.Key, issue.Fields.Status.Name)
+}
+
+// getIssueTransitions returns the transitions available for the issue,
+// including the fields shown on their screens
+func getIssueTransitions(ctx context.Context, client *jira.Client, issueID string) ([]jira.Transition, error) {
+	available := new(struct {
+		Transitions []jira.Transition `json:"transitions"`
+	})
+	urlStr := fmt.Sprintf("%s?expand=transitions.fields", issueTransitionsEndpoint(issueID))
+	if err := request(ctx, client, "GET", urlStr, nil, available); err != nil {
+		return nil, err
+	}
+	return available.Transitions, nil
+}
 
-	d.SetId(issue.ID)
+// doIssueTransition performs the transition, passing the resolution if the
+// transition asks for one and adding the comment if it is not empty
+func doIssueTransition(ctx context.Context, client *jira.Client, issue *jira.Issue, transition *jira.Transition, resolution string, comment string) error {
+	payload := map[string]interface{}{
+		"transition": map[string]string{"id": transition.ID},
+	}
+	if field, ok := transition.Fields["resolution"]; ok {
+		if resolution != "" {
+			payload["fields"] = map[string]interface{}{
+				"resolution": map[string]string{"name": resolution},
+			}
+		} else if field.Required {
+			return errors.Errorf("transition %q of issue %s requires a resolution", transition.Name, issue.Key)
+		}
+	}
+	if comment != "" {
+		payload["update"] = map[string]interface{}{
+			"comment": []interface{}{
+				map[string]interface{}{"add": map[string]string{"body": comment}},
+			},
+		}
+	}
 
-	return resourceIssueRead(d, m)
+	if err := request(ctx, client, "POST", issueTransitionsEndpoint(issue.ID), payload, nil); err != nil {
+		return errors.Wrapf(err, "transition %q failed", transition.Name)
+	}
+	return nil
 }
 
 // resourceIssueRead reads issue details using jira api
-func resourceIssueRead(d *schema.ResourceData, m interface{}) error {
+func resourceIssueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
-	issue, res, err := config.jiraClient.Issue.Get(d.Id(), nil)
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, d.Id(), nil)
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "getting jira issue failed: %s", body)
+		err = newAPIError("GET", issueEndpoint(d.Id()), res, err)
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "getting jira issue failed", nil)
 	}
 
 	if issue.Fields.Assignee != nil {
@@ -220,25 +978,135 @@ func resourceIssueRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("fields", incomingFields)
 	}
 
+	// Fields of fields_json are read in their raw form, as some of them are
+	// decoded into the standard fields of the issue
+	if fieldsJSON, ok := d.GetOk("fields_json"); ok {
+		configured, err := expandIssueFieldsJSON(fieldsJSON)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		names := make([]string, 0, len(configured))
+		for field := range configured {
+			names = append(names, field)
+		}
+		sort.Strings(names)
+
+		raw := new(struct {
+			Fields map[string]interface{} `json:"fields"`
+		})
+		query := url.Values{}
+		query.Set("fields", strings.Join(names, ","))
+		err = request(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?%s", issueEndpoint(d.Id()), query.Encode()), nil, raw)
+		if err != nil {
+			return errorDiagnostics(err, "getting fields of jira issue failed", nil)
+		}
+
+		values := make(map[string]interface{})
+		for field, value := range configured {
+			values[field] = pruneIssueFieldValue(raw.Fields[field], value)
+		}
+		encoded, err := json.Marshal(values)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("fields_json", string(encoded))
+	}
+
 	d.Set("labels", nil)
 	if issue.Fields.Labels != nil && len(issue.Fields.Labels) > 0 {
 		d.Set("labels", issue.Fields.Labels)
 	}
 
 	d.Set("issue_type", issue.Fields.Type.Name)
-	if issue.Fields.Description != "" {
-		d.Set("description", issue.Fields.Description)
+	if config.apiVersion == 3 {
+		raw := new(struct {
+			Fields struct {
+				Description json.RawMessage `json:"description"`
+				Environment json.RawMessage `json:"environment"`
+			} `json:"fields"`
+		})
+		err := request(ctx, config.jiraClient, "GET", fmt.Sprintf("%s?fields=description,environment", issueV3Endpoint(d.Id())), nil, raw)
+		if err != nil {
+			return errorDiagnostics(err, "getting description of jira issue failed", nil)
+		}
+		if err := flattenRichText(d, "description", "description_adf", raw.Fields.Description); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := flattenRichText(d, "environment", "", raw.Fields.Environment); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		if issue.Fields.Description != "" {
+			d.Set("description", issue.Fields.Description)
+		}
+		d.Set("environment", issue.Fields.Environment)
 	}
 	d.Set("summary", issue.Fields.Summary)
 	d.Set("project_key", issue.Fields.Project.Key)
 	d.Set("issue_key", issue.Key)
 	d.Set("state", issue.Fields.Status.ID)
+	d.Set("status", issue.Fields.Status.Name)
+
+	resolution := ""
+	if issue.Fields.Resolution != nil {
+		resolution = issue.Fields.Resolution.Name
+	}
+	d.Set("resolution", resolution)
+
+	priority := ""
+	if issue.Fields.Priority != nil {
+		priority = issue.Fields.Priority.Name
+	}
+	d.Set("priority", priority)
+
+	components := make([]string, 0, len(issue.Fields.Components))
+	for _, component := range issue.Fields.Components {
+		components = append(components, component.Name)
+	}
+	d.Set("components", components)
+
+	fixVersions := make([]string, 0, len(issue.Fields.FixVersions))
+	for _, version := range issue.Fields.FixVersions {
+		fixVersions = append(fixVersions, version.Name)
+	}
+	d.Set("fix_versions", fixVersions)
+
+	affectsVersions := make([]string, 0, len(issue.Fields.AffectsVersions))
+	for _, version := range issue.Fields.AffectsVersions {
+		affectsVersions = append(affectsVersions, version.Name)
+	}
+	d.Set("affects_versions", affectsVersions)
+
+	dueDate := ""
+	if t := time.Time(issue.Fields.Duedate); !t.IsZero() {
+		dueDate = t.Format("2006-01-02")
+	}
+	d.Set("due_date", dueDate)
+
+	timetracking := jira.TimeTracking{}
+	if issue.Fields.TimeTracking != nil {
+		timetracking = *issue.Fields.TimeTracking
+	}
+	setIssueEstimate(d, "original_estimate", timetracking.OriginalEstimate, timetracking.OriginalEstimateSeconds)
+	setIssueEstimate(d, "remaining_estimate", timetracking.RemainingEstimate, timetracking.RemainingEstimateSeconds)
+
+	parent := ""
+	if issue.Fields.Parent != nil {
+		parent = issue.Fields.Parent.Key
+	}
+	d.Set("parent", parent)
+
+	securityLevel := ""
+	if security, ok := issue.Fields.Unknowns["security"].(map[string]interface{}); ok {
+		securityLevel = fmt.Sprintf("%v", security["id"])
+	}
+	d.Set("security_level", securityLevel)
 
 	return nil
 }
 
 // resourceIssueUpdate updates jira issue using jira api
-func resourceIssueUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	assignee := d.Get("assignee")
 	reporter := d.Get("reporter")
@@ -292,54 +1160,94 @@ func resourceIssueUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	issue, res, err := config.jiraClient.Issue.Update(&i)
+	fieldsJSON, err := expandIssueFieldsJSON(d.Get("fields_json"))
 	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "updating jira issue failed: %s", body)
+		return diag.FromErr(err)
+	}
+	if d.HasChange("fields_json") {
+		if i.Fields.Unknowns == nil {
+			i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		}
+		// Fields removed from fields_json are cleared. The values are
+		// assigned directly, as Set removes keys with nil values instead of
+		// sending null.
+		o, _ := d.GetChange("fields_json")
+		oldFieldsJSON, _ := expandIssueFieldsJSON(o)
+		for field := range oldFieldsJSON {
+			if _, ok := fieldsJSON[field]; !ok {
+				i.Fields.Unknowns[field] = nil
+			}
+		}
+		for field, value := range fieldsJSON {
+			i.Fields.Unknowns[field] = value
+		}
 	}
 
-	issue, res, err = config.jiraClient.Issue.Get(issue.ID, nil)
-	if err != nil {
-		body, _ := ioutil.ReadAll(res.Body)
-		return errors.Wrapf(err, "getting jira issue failed: %s", body)
+	expandIssueStandardFields(d, &i, true)
+
+	if isEmptyStringConfig(d.GetRawConfig(), "security_level") {
+		if i.Fields.Unknowns == nil {
+			i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		}
+		// A null security level makes the issue visible to everyone again. It
+		// is assigned directly, as Set removes keys with nil values.
+		i.Fields.Unknowns["security"] = nil
+	} else if d.HasChange("security_level") {
+		if i.Fields.Unknowns == nil {
+			i.Fields.Unknowns = tcontainer.NewMarshalMap()
+		}
+		i.Fields.Unknowns.Set("security", map[string]interface{}{"id": d.Get("security_level").(string)})
 	}
 
-	if state, ok := d.GetOk("state"); ok {
-		if issue.Fields.Status.ID != state.(string) {
-			if transition, ok := d.GetOk("state_transition"); ok {
-				res, err := config.jiraClient.Issue.DoTransition(issue.ID, transition.(string))
-				if err != nil {
-					body, _ := ioutil.ReadAll(res.Body)
-					return errors.Wrapf(err, "transitioning jira issue failed: %s", body)
-				}
-			}
+	if diags := checkADFAPIVersion(config, d, "description_adf"); diags != nil {
+		return diags
+	}
+
+	if config.apiVersion == 3 {
+		payload, err := issueV3Payload(d, &i, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := request(ctx, config.jiraClient, "PUT", issueV3Endpoint(issueKey), payload, nil); err != nil {
+			return errorDiagnostics(err, "updating jira issue failed", issueFieldsJSONAttributePath(fieldsJSON))
+		}
+	} else {
+		_, res, err := config.jiraClient.Issue.UpdateWithContext(ctx, &i)
+		if err != nil {
+			return errorDiagnostics(newAPIError("PUT", issueEndpoint(issueKey), res, err), "updating jira issue failed", issueFieldsJSONAttributePath(fieldsJSON))
 		}
 	}
 
-	d.SetId(issue.ID)
+	issue, res, err := config.jiraClient.Issue.GetWithContext(ctx, d.Id(), nil)
+	if err != nil {
+		return errorDiagnostics(newAPIError("GET", issueEndpoint(d.Id()), res, err), "getting jira issue failed", nil)
+	}
+	setAppliedIssueEstimateSeconds(d, issue, true)
 
-	return resourceIssueRead(d, m)
+	if diags := resourceIssueTransition(ctx, d, config, issue); diags.HasError() {
+		return diags
+	}
+
+	return resourceIssueRead(ctx, d, m)
 }
 
 // resourceIssueDelete deletes jira issue using the jira api
-func resourceIssueDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIssueDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	id := d.Id()
 
 	if transition, ok := d.GetOk("delete_transition"); ok {
-		res, err := config.jiraClient.Issue.DoTransition(id, transition.(string))
+		res, err := config.jiraClient.Issue.DoTransitionWithContext(ctx, id, transition.(string))
 		if err != nil {
-			body, _ := ioutil.ReadAll(res.Body)
-			return errors.Wrapf(err, "deleting jira issue failed: %s", body)
+			return errorDiagnostics(newAPIError("POST", issueTransitionsEndpoint(id), res, err), "deleting jira issue failed", nil)
 		}
 
 	} else {
-		res, err := config.jiraClient.Issue.Delete(id)
+		res, err := config.jiraClient.Issue.DeleteWithContext(ctx, id)
 
 		if err != nil {
-			body, _ := ioutil.ReadAll(res.Body)
-			return errors.Wrapf(err, "deleting jira issue failed: %s", body)
+			return errorDiagnostics(newAPIError("DELETE", issueEndpoint(id), res, err), "deleting jira issue failed", nil)
 		}
 	}
 
@@ -347,10 +1255,10 @@ func resourceIssueDelete(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceIssueImport imports jira issue using the jira api
-func resourceIssueImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	err := resourceIssueRead(d, m)
-	if err != nil {
-		return []*schema.ResourceData{}, err
+func resourceIssueImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	diags := resourceIssueRead(ctx, d, m)
+	if diags.HasError() {
+		return []*schema.ResourceData{}, fmt.Errorf("importing jira issue failed: %s", diags[0].Detail)
 	}
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// resourceIssueAttachment is used to attach a file to a JIRA issue.
+// Attachments can't be changed, every change replaces the attachment.
+func resourceIssueAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueAttachmentCreate,
+		ReadContext:   resourceIssueAttachmentRead,
+		DeleteContext: resourceIssueAttachmentDelete,
+		CustomizeDiff: resourceIssueAttachmentCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Attaches a file to an issue",
+
+		Schema: map[string]*schema.Schema{
+			"issue_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source", "content"},
+				Description:  "Path of a local file to upload. The attachment is replaced when the content of the file changes",
+			},
+			"content": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Content of the attachment, as an alternative to source",
+			},
+			"filename": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the attachment. Defaults to the name of the source file, required with content",
+			},
+			// Computed values
+			"content_sha256": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the uploaded content, hex encoded",
+			},
+			"size": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the attachment in bytes",
+			},
+			"mime_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func attachmentEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", attachmentAPIEndpoint, id)
+}
+
+// attachmentContent returns the content to upload, read from source or
+// taken from content
+func attachmentContent(source string, content string) ([]byte, error) {
+	if source == "" {
+		return []byte(content), nil
+	}
+	return ioutil.ReadFile(source)
+}
+
+func contentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// resourceIssueAttachmentCustomizeDiff plans the replacement of the
+// attachment when the content changes. Files which don't exist yet, e.g.
+// because they are generated during apply, are hashed on upload.
+func resourceIssueAttachmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	source, content := d.Get("source").(string), d.Get("content").(string)
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content") {
+		return d.SetNewComputed("content_sha256")
+	}
+
+	if source == "" && d.GetRawConfig().GetAttr("filename").IsNull() {
+		return errors.New("filename is required when the attachment is created from content")
+	}
+
+	old, _ := d.GetChange("content_sha256")
+	data, err := attachmentContent(source, content)
+	if err != nil {
+		if err := d.SetNewComputed("content_sha256"); err != nil || d.Id() == "" {
+			return err
+		}
+		return d.ForceNew("content_sha256")
+	}
+
+	if hash := contentHash(data); hash != old.(string) {
+		if err := d.SetNew("content_sha256", hash); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("content_sha256")
+		}
+	}
+	return nil
+}
+
+// resourceIssueAttachmentCreate uploads the attachment using the jira api
+func resourceIssueAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+	source := d.Get("source").(string)
+
+	content, err := attachmentContent(source, d.Get("content").(string))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "reading attachment source failed"))
+	}
+
+	filename := d.Get("filename").(string)
+	if filename == "" {
+		filename = filepath.Base(source)
+	}
+
+	attachments, res, err := config.jiraClient.Issue.PostAttachmentWithContext(ctx, issueKey, bytes.NewReader(content), filename)
+	if err != nil {
+		return errorDiagnostics(newAPIError("POST", issueEndpoint(issueKey)+"/attachments", res, err), "creating jira issue attachment failed", nil)
+	}
+	if attachments == nil || len(*attachments) != 1 {
+		return diag.Errorf("creating jira issue attachment failed: JIRA did not return the attachment")
+	}
+
+	d.SetId((*attachments)[0].ID)
+	d.Set("content_sha256", contentHash(content))
+
+	return resourceIssueAttachmentRead(ctx, d, m)
+}
+
+// resourceIssueAttachmentRead reads attachment details using jira api. The
+// content of attachments can't change, so it is not downloaded.
+func resourceIssueAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	attachment := new(struct {
+		Filename string `json:"filename"`
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+	})
+	err := request(ctx, config.jiraClient, "GET", attachmentEndpoint(d.Id()), nil, attachment)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "getting jira issue attachment failed", nil)
+	}
+
+	d.Set("filename", attachment.Filename)
+	d.Set("size", attachment.Size)
+	d.Set("mime_type", attachment.MimeType)
+
+	return nil
+}
+
+// resourceIssueAttachmentDelete deletes the attachment using the jira api
+func resourceIssueAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", attachmentEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue attachment failed", nil)
+	}
+
+	return nil
+}
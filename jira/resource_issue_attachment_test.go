@@ -0,0 +1,213 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraIssueAttachment_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	var inlineID, fileID string
+
+	dir, err := ioutil.TempDir("", "attachment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "diagram.txt")
+	writeSource := func(content string) {
+		if err := ioutil.WriteFile(source, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("first diagram")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueAttachmentConfig(rInt, "Restart the service", source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueAttachmentExists("jira_issue_attachment.inline"),
+					testAccStoreResourceID("jira_issue_attachment.inline", &inlineID),
+					testAccStoreResourceID("jira_issue_attachment.file", &fileID),
+					resource.TestCheckResourceAttr("jira_issue_attachment.inline", "filename", "runbook.md"),
+					resource.TestCheckResourceAttr("jira_issue_attachment.inline", "size", "19"),
+					resource.TestCheckResourceAttr("jira_issue_attachment.inline", "mime_type", "text/plain"),
+					resource.TestCheckResourceAttr("jira_issue_attachment.inline", "content_sha256", contentHash([]byte("Restart the service"))),
+					resource.TestCheckResourceAttr("jira_issue_attachment.file", "filename", "diagram.txt"),
+					resource.TestCheckResourceAttr("jira_issue_attachment.file", "content_sha256", contentHash([]byte("first diagram"))),
+				),
+			},
+			{
+				// The file attachment is replaced when the content of the file changes
+				PreConfig: func() {
+					writeSource("second diagram")
+				},
+				Config: testAccJiraIssueAttachmentConfig(rInt, "Restart the service", source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueAttachmentReplaced("jira_issue_attachment.inline", &inlineID, false),
+					testAccCheckJiraIssueAttachmentReplaced("jira_issue_attachment.file", &fileID, true),
+					resource.TestCheckResourceAttr("jira_issue_attachment.file", "size", "14"),
+					resource.TestCheckResourceAttr("jira_issue_attachment.file", "content_sha256", contentHash([]byte("second diagram"))),
+				),
+			},
+			{
+				Config: testAccJiraIssueAttachmentConfig(rInt, "Restart the service twice", source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueAttachmentReplaced("jira_issue_attachment.inline", &inlineID, true),
+					testAccCheckJiraIssueAttachmentReplaced("jira_issue_attachment.file", &fileID, false),
+					resource.TestCheckResourceAttr("jira_issue_attachment.inline", "size", "25"),
+				),
+			},
+			{
+				Config: testAccJiraIssueAttachmentConfig(rInt, "Restart the service", source) + `
+resource "jira_issue_attachment" "unnamed" {
+  issue_key = jira_issue.foo.issue_key
+  content   = "Unnamed"
+}
+`,
+				ExpectError: regexp.MustCompile(`filename is required when the attachment is created from content`),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueAttachment_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_attachment.inline"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueAttachmentConfig(rInt, "Restart the service", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", attachmentEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueAttachmentConfig(rInt, "Restart the service", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueAttachmentExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_attachment" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", attachmentEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Attachment %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No attachment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", attachmentEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Attachment %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+// testAccCheckJiraIssueAttachmentReplaced checks whether the attachment got
+// a new ID, and stores the current ID
+func testAccCheckJiraIssueAttachmentReplaced(n string, id *string, replaced bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if (rs.Primary.ID != *id) != replaced {
+			return fmt.Errorf("Attachment %s has ID %s, was %s, expected replacement: %t", n, rs.Primary.ID, *id, replaced)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccJiraIssueAttachmentConfig attaches content inline and, if source is
+// set, the file at source to an issue
+func testAccJiraIssueAttachmentConfig(rInt int, content string, source string) string {
+	file := ""
+	if source != "" {
+		file = fmt.Sprintf(`
+resource "jira_issue_attachment" "file" {
+  issue_key = jira_issue.foo.issue_key
+  source    = %q
+}
+`, source)
+	}
+
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+}
+
+resource "jira_issue_attachment" "inline" {
+  issue_key = jira_issue.foo.issue_key
+  filename  = "runbook.md"
+  content   = %q
+}
+%s`, rInt, rInt, rInt%100000, content, file)
+}
@@ -1,21 +1,28 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceIssueLink() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIssueLinkCreate,
-		Read:   resourceIssueLinkRead,
-		Delete: resourceIssueLinkDelete,
+		CreateContext: resourceIssueLinkCreate,
+		ReadContext:   resourceIssueLinkRead,
+		DeleteContext: resourceIssueLinkDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -39,7 +46,7 @@ func resourceIssueLink() *schema.Resource {
 }
 
 // resourceIssueLinkCreate creates a new jira issue using the jira api
-func resourceIssueLinkCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	issueLink := new(jira.IssueLink)
@@ -48,16 +55,16 @@ func resourceIssueLinkCreate(d *schema.ResourceData, m interface{}) error {
 	issueLink.OutwardIssue = &jira.Issue{Key: d.Get("outward_key").(string)}
 	issueLink.Type = jira.IssueLinkType{ID: d.Get("link_type").(string)}
 
-	resp, err := config.jiraClient.Issue.AddLink(issueLink)
+	resp, err := config.jiraClient.Issue.AddLinkWithContext(ctx, issueLink)
 
 	if err != nil {
-		return errors.Wrap(err, "Creating Issue Link failed")
+		return errorDiagnostics(err, "creating jira issue link failed", nil)
 	}
 
 	location, err := resp.Location()
 
 	if err != nil {
-		return errors.Wrap(err, "Creating Issue Link failed")
+		return errorDiagnostics(err, "creating jira issue link failed", nil)
 	}
 
 	components := strings.Split(location.Path, "/")
@@ -65,19 +72,23 @@ func resourceIssueLinkCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(ID)
 
-	return resourceIssueLinkRead(d, m)
+	return resourceIssueLinkRead(ctx, d, m)
 }
 
 // resourceIssueLinkRead reads issue details using jira api
-func resourceIssueLinkRead(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, d.Id())
 	issueLink := new(jira.IssueLink)
 
-	err := request(config.jiraClient, "GET", urlStr, nil, issueLink)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, issueLink)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue link failed", nil)
 	}
 
 	d.Set("inward_key", issueLink.InwardIssue.Key)
@@ -88,14 +99,14 @@ func resourceIssueLinkRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceIssueLinkDelete deletes jira issue using the jira api
-func resourceIssueLinkDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira issue link failed", nil)
 	}
 
 	return nil
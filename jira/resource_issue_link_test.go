@@ -0,0 +1,150 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraIssueLink_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_link.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueLinkConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueLinkExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueLink_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_link.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueLinkConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, id)
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueLinkConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueLinkExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueLinkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_link" {
+			continue
+		}
+
+		urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Issue link %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueLinkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No issue link ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		urlStr := fmt.Sprintf("%s/%s", issueLinkAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if err != nil {
+			return fmt.Errorf("Issue link %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraIssueLinkConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = "${jira_user.foo.name}"
+  project_type_key     = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_issue" "inward" {
+  issue_type  = "Task"
+  project_key = "${jira_project.foo.key}"
+  summary     = "Blocked issue"
+}
+
+resource "jira_issue" "outward" {
+  issue_type  = "Task"
+  project_key = "${jira_project.foo.key}"
+  summary     = "Blocking issue"
+}
+
+resource "jira_issue_link_type" "foo" {
+  name    = "foo-blocks-%d"
+  inward  = "is blocked by"
+  outward = "blocks"
+}
+
+resource "jira_issue_link" "foo" {
+  inward_key  = "${jira_issue.inward.issue_key}"
+  outward_key = "${jira_issue.outward.issue_key}"
+  link_type   = "${jira_issue_link_type.foo.id}"
+}`, rInt, rInt, rInt%100000, rInt)
+}
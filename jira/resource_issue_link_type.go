@@ -1,21 +1,29 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
 func resourceIssueLinkType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIssueLinkTypeCreate,
-		Read:   resourceIssueLinkTypeRead,
-		Update: resourceIssueLinkTypeUpdate,
-		Delete: resourceIssueLinkTypeDelete,
+		CreateContext: resourceIssueLinkTypeCreate,
+		ReadContext:   resourceIssueLinkTypeRead,
+		UpdateContext: resourceIssueLinkTypeUpdate,
+		DeleteContext: resourceIssueLinkTypeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,7 +44,7 @@ func resourceIssueLinkType() *schema.Resource {
 }
 
 // resourceIssueLinkTypeCreate creates a new jira issue using the jira api
-func resourceIssueLinkTypeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	issueLinkType := new(jira.IssueLinkType)
@@ -47,25 +55,29 @@ func resourceIssueLinkTypeCreate(d *schema.ResourceData, m interface{}) error {
 
 	returnedIssueLinkType := new(jira.IssueLinkType)
 
-	err := request(config.jiraClient, "POST", issueLinkTypeAPIEndpoint, issueLinkType, returnedIssueLinkType)
+	err := request(ctx, config.jiraClient, "POST", issueLinkTypeAPIEndpoint, issueLinkType, returnedIssueLinkType)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira issue link type failed", nil)
 	}
 
 	d.SetId(returnedIssueLinkType.ID)
 
-	return resourceIssueLinkTypeRead(d, m)
+	return resourceIssueLinkTypeRead(ctx, d, m)
 }
 
 // resourceIssueLinkTypeRead reads issue details using jira api
-func resourceIssueLinkTypeRead(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	urlStr := fmt.Sprintf("%s/%s", issueLinkTypeAPIEndpoint, d.Id())
 	issueLinkType := new(jira.IssueLinkType)
 
-	err := request(config.jiraClient, "GET", urlStr, nil, issueLinkType)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, issueLinkType)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue link type failed", nil)
 	}
 
 	d.Set("name", issueLinkType.Name)
@@ -76,7 +88,7 @@ func resourceIssueLinkTypeRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceIssueLinkTypeUpdate updates jira issue using jira api
-func resourceIssueLinkTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	issueLinkType := new(jira.IssueLinkType)
@@ -88,23 +100,23 @@ func resourceIssueLinkTypeUpdate(d *schema.ResourceData, m interface{}) error {
 	urlStr := fmt.Sprintf("%s/%s", issueLinkTypeAPIEndpoint, d.Id())
 	returnedIssueLinkType := new(jira.IssueLinkType)
 
-	err := request(config.jiraClient, "PUT", urlStr, issueLinkType, returnedIssueLinkType)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, issueLinkType, returnedIssueLinkType)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira issue link type failed", nil)
 	}
 
-	return resourceIssueLinkTypeRead(d, m)
+	return resourceIssueLinkTypeRead(ctx, d, m)
 }
 
 // resourceIssueLinkTypeDelete deletes jira issue using the jira api
-func resourceIssueLinkTypeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIssueLinkTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueLinkTypeAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira issue link type failed", nil)
 	}
 
 	return nil
@@ -0,0 +1,348 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// IssueSecurityLevelMember is a holder of an issue security level
+type IssueSecurityLevelMember struct {
+	ID                   int              `json:"id,omitempty"`
+	IssueSecurityLevelID int              `json:"issueSecurityLevelId,omitempty"`
+	Holder               PermissionHolder `json:"holder"`
+}
+
+// IssueSecurityLevelRequest The struct sent to the JIRA instance to create an issue security level
+type IssueSecurityLevelRequest struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	IsDefault   bool               `json:"isDefault"`
+	Members     []PermissionHolder `json:"members,omitempty"`
+}
+
+var issueSecurityLevelMemberTypes = []string{
+	"group",
+	"projectRole",
+	"user",
+	"reporter",
+	"assignee",
+	"projectLead",
+	"applicationRole",
+}
+
+// resourceIssueSecurityLevel is used to define a level of a JIRA issue security scheme
+func resourceIssueSecurityLevel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueSecurityLevelCreate,
+		ReadContext:   resourceIssueSecurityLevelRead,
+		UpdateContext: resourceIssueSecurityLevelUpdate,
+		DeleteContext: resourceIssueSecurityLevelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a level of an issue security scheme. The ID has the format <scheme_id>:<level_id>. " +
+			"The level_id can be used as security_level of jira_issue",
+
+		Schema: map[string]*schema.Schema{
+			"scheme_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the issue security scheme",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the issue security level",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the issue security level",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this is the default level of the scheme. At most one level of a scheme can be the default",
+			},
+			"member": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIssueSecurityLevelMemberHash,
+				Description: "Users who can see issues with this security level",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Type of the member. Needs to be one of group, projectRole, user, " +
+								"reporter, assignee, projectLead or applicationRole",
+							ValidateFunc: func(v interface{}, s string) ([]string, []error) {
+								if !containsString(issueSecurityLevelMemberTypes, v.(string)) {
+									return nil, []error{fmt.Errorf("type needs to be one of group, projectRole, user, reporter, assignee, projectLead or applicationRole")}
+								}
+								return nil, nil
+							},
+						},
+						"parameter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Group name, project role ID, user key (account ID on JIRA Cloud) or application key " +
+								"of the member. Must be empty for reporter, assignee and projectLead",
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"level_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIssueSecurityLevelMemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	for _, attribute := range []string{"type", "parameter"} {
+		if v, ok := m[attribute]; ok {
+			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+		}
+	}
+
+	return HashString(buf.String())
+}
+
+// splitIssueSecurityLevelID splits an ID of the format <scheme_id>:<level_id>
+func splitIssueSecurityLevelID(id string) (string, string, error) {
+	components := strings.SplitN(id, ":", 2)
+	if len(components) != 2 {
+		return "", "", fmt.Errorf("invalid ID %q, expected <scheme_id>:<level_id>", id)
+	}
+	return components[0], components[1], nil
+}
+
+func expandIssueSecurityLevelMembers(members []interface{}) []PermissionHolder {
+	holders := make([]PermissionHolder, 0, len(members))
+	for _, v := range members {
+		m := v.(map[string]interface{})
+		holders = append(holders, PermissionHolder{
+			Type:      m["type"].(string),
+			Parameter: m["parameter"].(string),
+		})
+	}
+	return holders
+}
+
+// setIssueSecurityLevelDefault makes the level the default of the scheme or
+// removes it as the default
+func setIssueSecurityLevelDefault(ctx context.Context, client *jira.Client, schemeID string, levelID string, isDefault bool) error {
+	body := map[string]interface{}{
+		"defaultValues": []map[string]interface{}{{
+			"issueSecurityLevelId": levelID,
+			"isDefault":            isDefault,
+		}},
+	}
+	urlStr := fmt.Sprintf("%s/level/default", issueSecuritySchemeEndpoint(schemeID))
+	return request(ctx, client, "PUT", urlStr, body, nil)
+}
+
+// resourceIssueSecurityLevelCreate creates a new jira issue security level using the jira api
+func resourceIssueSecurityLevelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	schemeID := d.Get("scheme_id").(string)
+	name := d.Get("name").(string)
+
+	level := &IssueSecurityLevelRequest{
+		Name:        name,
+		Description: d.Get("description").(string),
+		IsDefault:   d.Get("default").(bool),
+		Members:     expandIssueSecurityLevelMembers(d.Get("member").(*schema.Set).List()),
+	}
+	body := map[string]interface{}{
+		"levels": []*IssueSecurityLevelRequest{level},
+	}
+
+	urlStr := fmt.Sprintf("%s/level", issueSecuritySchemeEndpoint(schemeID))
+	err := request(ctx, config.jiraClient, "PUT", urlStr, body, nil)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue security level failed", nil)
+	}
+
+	// JIRA doesn't return the ID of the new level, but level names are unique
+	// within a scheme
+	scheme := new(IssueSecurityScheme)
+	err = request(ctx, config.jiraClient, "GET", issueSecuritySchemeEndpoint(schemeID), nil, scheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue security level failed", nil)
+	}
+	for _, l := range scheme.Levels {
+		if l.Name == name {
+			d.SetId(fmt.Sprintf("%s:%s", schemeID, l.ID))
+			d.Set("level_id", l.ID)
+		}
+	}
+	if d.Id() == "" {
+		return diag.Errorf("creating jira issue security level failed: level %q not found in scheme %s", name, schemeID)
+	}
+
+	return resourceIssueSecurityLevelRead(ctx, d, m)
+}
+
+// resourceIssueSecurityLevelRead reads issue security level details using jira api
+func resourceIssueSecurityLevelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	schemeID, levelID, err := splitIssueSecurityLevelID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	scheme := new(IssueSecurityScheme)
+	err = request(ctx, config.jiraClient, "GET", issueSecuritySchemeEndpoint(schemeID), nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue security level failed", nil)
+	}
+
+	var level *IssueSecurityLevel
+	for i := range scheme.Levels {
+		if scheme.Levels[i].ID == levelID {
+			level = &scheme.Levels[i]
+		}
+	}
+	if level == nil {
+		d.SetId("")
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("schemeId", schemeID)
+	query.Set("levelId", levelID)
+
+	members := &schema.Set{
+		F: resourceIssueSecurityLevelMemberHash,
+	}
+	err = requestPages(ctx, config.jiraClient, issueSecuritySchemeAPIEndpoint+"/level/member", query, func(value json.RawMessage) error {
+		member := new(IssueSecurityLevelMember)
+		if err := json.Unmarshal(value, member); err != nil {
+			return err
+		}
+		members.Add(map[string]interface{}{
+			"type":      member.Holder.Type,
+			"parameter": member.Holder.Parameter,
+			"id":        strconv.Itoa(member.ID),
+		})
+		return nil
+	})
+	if err != nil {
+		return errorDiagnostics(err, "reading jira issue security level members failed", nil)
+	}
+
+	d.Set("scheme_id", strconv.Itoa(scheme.ID))
+	d.Set("level_id", level.ID)
+	d.Set("name", level.Name)
+	d.Set("description", level.Description)
+	d.Set("default", strconv.Itoa(scheme.DefaultSecurityLevelID) == level.ID)
+	d.Set("member", members)
+
+	return nil
+}
+
+// resourceIssueSecurityLevelUpdate updates jira issue security level using jira api
+func resourceIssueSecurityLevelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	schemeID, levelID, err := splitIssueSecurityLevelID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	levelEndpoint := fmt.Sprintf("%s/level/%s", issueSecuritySchemeEndpoint(schemeID), levelID)
+
+	if d.HasChanges("name", "description") {
+		level := &IssueSecurityLevel{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", levelEndpoint, level, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira issue security level failed", nil)
+		}
+	}
+
+	if d.HasChange("default") {
+		if err := setIssueSecurityLevelDefault(ctx, config.jiraClient, schemeID, levelID, d.Get("default").(bool)); err != nil {
+			return errorDiagnostics(err, "updating default of jira issue security level failed", nil)
+		}
+	}
+
+	if d.HasChange("member") {
+		o, n := d.GetChange("member")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, member := range os.Difference(ns).List() {
+			urlStr := fmt.Sprintf("%s/member/%s", levelEndpoint, member.(map[string]interface{})["id"])
+			err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+			if err != nil && !errors.Is(err, ResourceNotFoundError) {
+				return errorDiagnostics(err, "removing jira issue security level member failed", nil)
+			}
+		}
+
+		if added := ns.Difference(os).List(); len(added) > 0 {
+			body := map[string]interface{}{
+				"members": expandIssueSecurityLevelMembers(added),
+			}
+			err := request(ctx, config.jiraClient, "PUT", levelEndpoint+"/member", body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "adding jira issue security level members failed", nil)
+			}
+		}
+	}
+
+	return resourceIssueSecurityLevelRead(ctx, d, m)
+}
+
+// resourceIssueSecurityLevelDelete deletes jira issue security level using the jira api
+func resourceIssueSecurityLevelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	schemeID, levelID, err := splitIssueSecurityLevelID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	urlStr := fmt.Sprintf("%s/level/%s", issueSecuritySchemeEndpoint(schemeID), levelID)
+	err = request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue security level failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraIssueSecurityLevel_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_security_level.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueSecurityLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueSecurityLevelConfig(rInt, "foo", true, `
+  member {
+    type      = "group"
+    parameter = jira_group.foo.name
+  }
+
+  member {
+    type = "reporter"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueSecurityLevelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-level-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "2"),
+					resource.TestCheckResourceAttrPair("jira_issue.foo", "security_level", resourceName, "level_id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueSecurityLevelConfig(rInt, "bar", false, `
+  member {
+    type      = "projectRole"
+    parameter = jira_role.foo.id
+  }
+
+  member {
+    type = "reporter"
+  }
+
+  member {
+    type = "assignee"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueSecurityLevelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-level-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "member.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueSecurityLevel_issueDefault(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_security_level.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueSecurityLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Issues without security level get the default level
+				Config: testAccJiraIssueSecurityLevelIssueConfig(rInt, "foo", true, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jira_issue.foo", "security_level", resourceName, "level_id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueSecurityLevelIssueConfig(rInt, "foo", true, "", `""`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jira_issue.foo", "security_level", ""),
+					testAccCheckJiraIssueUnrestricted("jira_issue.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueSecurityLevel_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_security_level.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueSecurityLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueSecurityLevelBaseConfig(rInt, "foo", false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					schemeID, levelID, _ := splitIssueSecurityLevelID(id)
+					urlStr := fmt.Sprintf("%s/level/%s", issueSecuritySchemeEndpoint(schemeID), levelID)
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueSecurityLevelBaseConfig(rInt, "foo", false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueSecurityLevelExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckJiraIssueUnrestricted checks that the issue has no security level
+func testAccCheckJiraIssueUnrestricted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		issue, _, err := client.Issue.Get(rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if security, ok := issue.Fields.Unknowns["security"]; ok && security != nil {
+			return fmt.Errorf("Issue %s has security level %v", issue.Key, security)
+		}
+		return nil
+	}
+}
+
+// getIssueSecurityLevel returns the level with the ID <scheme_id>:<level_id>,
+// or nil if it does not exist
+func getIssueSecurityLevel(id string) (*IssueSecurityLevel, error) {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	schemeID, levelID, err := splitIssueSecurityLevelID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := new(IssueSecurityScheme)
+	err = request(context.Background(), client, "GET", issueSecuritySchemeEndpoint(schemeID), nil, scheme)
+	if errors.Is(err, ResourceNotFoundError) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range scheme.Levels {
+		if scheme.Levels[i].ID == levelID {
+			return &scheme.Levels[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccCheckJiraIssueSecurityLevelDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_security_level" {
+			continue
+		}
+
+		level, err := getIssueSecurityLevel(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if level != nil {
+			return fmt.Errorf("Issue security level %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueSecurityLevelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No issue security level ID is set")
+		}
+
+		level, err := getIssueSecurityLevel(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if level == nil {
+			return fmt.Errorf("Issue security level %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraIssueSecurityLevelBaseConfig(rInt int, name string, isDefault bool, members string) string {
+	return fmt.Sprintf(`
+resource "jira_group" "foo" {
+  name = "foo-group-%d"
+}
+
+resource "jira_role" "foo" {
+  name = "foo-role-%d"
+}
+
+resource "jira_issue_security_scheme" "foo" {
+  name = "foo-scheme-%d"
+}
+
+resource "jira_issue_security_level" "foo" {
+  scheme_id   = jira_issue_security_scheme.foo.id
+  name        = "%s-level-%d"
+  description = "Created by Terraform"
+  default     = %t
+%s
+}
+`, rInt, rInt, rInt, name, rInt, isDefault, members)
+}
+
+func testAccJiraIssueSecurityLevelConfig(rInt int, name string, isDefault bool, members string) string {
+	return testAccJiraIssueSecurityLevelIssueConfig(rInt, name, isDefault, members, "jira_issue_security_level.foo.level_id")
+}
+
+// testAccJiraIssueSecurityLevelIssueConfig adds an issue with the given
+// security level expression, which is omitted if empty
+func testAccJiraIssueSecurityLevelIssueConfig(rInt int, name string, isDefault bool, members string, securityLevel string) string {
+	if securityLevel != "" {
+		securityLevel = "security_level = " + securityLevel
+	}
+	return testAccJiraIssueSecurityLevelBaseConfig(rInt, name, isDefault, members) + fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                  = "foo-name-%d"
+  key                   = "PX%d"
+  lead                  = jira_user.foo.name
+  project_type_key      = "software"
+  project_template_key  = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_security_scheme = jira_issue_security_scheme.foo.id
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+  %s
+}
+`, rInt, rInt, rInt%100000, securityLevel)
+}
@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// IssueSecurityLevel is a level of an issue security scheme
+type IssueSecurityLevel struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// IssueSecurityScheme The struct sent to and returned by the JIRA instance to manage issue security schemes
+type IssueSecurityScheme struct {
+	ID                     int                  `json:"id,omitempty"`
+	Name                   string               `json:"name"`
+	Description            string               `json:"description"`
+	DefaultSecurityLevelID int                  `json:"defaultSecurityLevelId,omitempty"`
+	Levels                 []IssueSecurityLevel `json:"levels,omitempty"`
+}
+
+// resourceIssueSecurityScheme is used to define a JIRA issue security scheme
+func resourceIssueSecurityScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueSecuritySchemeCreate,
+		ReadContext:   resourceIssueSecuritySchemeRead,
+		UpdateContext: resourceIssueSecuritySchemeUpdate,
+		DeleteContext: resourceIssueSecuritySchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates an issue security scheme. The levels are managed using jira_issue_security_level. " +
+			"The ID can be used as issue_security_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the issue security scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the issue security scheme",
+			},
+		},
+	}
+}
+
+func issueSecuritySchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", issueSecuritySchemeAPIEndpoint, id)
+}
+
+// resourceIssueSecuritySchemeCreate creates a new jira issue security scheme using the jira api
+func resourceIssueSecuritySchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &IssueSecurityScheme{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	returnedScheme := new(struct {
+		ID string `json:"id"`
+	})
+	err := request(ctx, config.jiraClient, "POST", issueSecuritySchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue security scheme failed", nil)
+	}
+
+	d.SetId(returnedScheme.ID)
+
+	return resourceIssueSecuritySchemeRead(ctx, d, m)
+}
+
+// resourceIssueSecuritySchemeRead reads issue security scheme details using jira api
+func resourceIssueSecuritySchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := new(IssueSecurityScheme)
+	err := request(ctx, config.jiraClient, "GET", issueSecuritySchemeEndpoint(d.Id()), nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue security scheme failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(scheme.ID))
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+
+	return nil
+}
+
+// resourceIssueSecuritySchemeUpdate updates jira issue security scheme using jira api
+func resourceIssueSecuritySchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &IssueSecurityScheme{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	err := request(ctx, config.jiraClient, "PUT", issueSecuritySchemeEndpoint(d.Id()), scheme, nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira issue security scheme failed", nil)
+	}
+
+	return resourceIssueSecuritySchemeRead(ctx, d, m)
+}
+
+// resourceIssueSecuritySchemeDelete deletes jira issue security scheme using the jira api
+func resourceIssueSecuritySchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", issueSecuritySchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue security scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraIssueSecurityScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_security_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueSecuritySchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueSecuritySchemeConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueSecuritySchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "issue_security_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueSecuritySchemeConfig(rInt, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueSecuritySchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueSecurityScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_security_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueSecuritySchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueSecuritySchemeBaseConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", issueSecuritySchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueSecuritySchemeBaseConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueSecuritySchemeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueSecuritySchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_security_scheme" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", issueSecuritySchemeEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Issue security scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueSecuritySchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No issue security scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", issueSecuritySchemeEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Issue security scheme %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraIssueSecuritySchemeBaseConfig(rInt int, name string) string {
+	return fmt.Sprintf(`
+resource "jira_issue_security_scheme" "foo" {
+  name        = "%s-scheme-%d"
+  description = "Created by Terraform"
+}
+`, name, rInt)
+}
+
+func testAccJiraIssueSecuritySchemeConfig(rInt int, name string) string {
+	return testAccJiraIssueSecuritySchemeBaseConfig(rInt, name) + fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                  = "foo-name-%d"
+  key                   = "PX%d"
+  lead                  = jira_user.foo.name
+  project_type_key      = "software"
+  project_template_key  = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_security_scheme = jira_issue_security_scheme.foo.id
+}
+`, rInt, rInt, rInt%100000)
+}
@@ -1,12 +1,18 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	jira "github.com/andygrunwald/go-jira"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 )
 
 func TestAccJiraIssue_basic(t *testing.T) {
@@ -34,6 +40,652 @@ func TestAccJiraIssue_basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraIssue_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue.example"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", issueEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssue_status(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Done can only be reached through In Progress and the review status
+				Config: testAccJiraIssueStatusConfig(rInt, `
+  status             = "done"
+  resolution         = "Won't Do"
+  transition_comment = "Closed by Terraform"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueStatus(resourceName, "10000"),
+					testAccCheckJiraIssueComments(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "status", "Done"),
+					resource.TestCheckResourceAttr(resourceName, "state", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "resolution", "Won't Do"),
+				),
+			},
+			{
+				// The resolution is changed by the transition from Done back into it
+				Config: testAccJiraIssueStatusConfig(rInt, `
+  status     = "Done"
+  resolution = "Duplicate"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueStatus(resourceName, "10000"),
+					testAccCheckJiraIssueComments(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "resolution", "Duplicate"),
+				),
+			},
+			{
+				Config: testAccJiraIssueStatusConfig(rInt, `
+  status = jira_status.review.name
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueComments(resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "state", "jira_status.review", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "status", "jira_status.review", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"transition_comment"},
+			},
+			{
+				// No transition leads from the review status back into it
+				Config: testAccJiraIssueStatusConfig(rInt, `
+  status     = jira_status.review.name
+  resolution = "Won't Do"
+`),
+				ExpectError: regexp.MustCompile(`resolution\s+can\s+only\s+be\s+changed\s+together\s+with\s+the\s+status`),
+			},
+			{
+				Config: testAccJiraIssueStatusConfig(rInt, `
+  status = jira_status.review.name
+}
+
+resource "jira_issue" "bar" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+  status      = "Done"
+`),
+				ExpectError: regexp.MustCompile(`transition "Close" of issue PX\d+-2 requires a resolution`),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssue_fieldsJSON(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueFieldsJSONConfig(rInt, `
+    (jira_custom_field.select.id)   = { value = "B" }
+    (jira_custom_field.multi.id)    = [{ value = "Y" }, { value = "X" }]
+    (jira_custom_field.cascade.id)  = { value = "A", child = { value = "A1" } }
+    (jira_custom_field.user.id)     = { name = jira_user.foo.name }
+    (jira_custom_field.date.id)     = "2024-05-01"
+    (jira_custom_field.number.id)   = 3.5
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueExists(resourceName),
+					resource.TestCheckResourceAttrWith(resourceName, "fields_json", func(value string) error {
+						if strings.Contains(value, "self") {
+							return fmt.Errorf("fields_json contains keys added by JIRA: %s", value)
+						}
+						return nil
+					}),
+					testAccCheckJiraIssueRawField(resourceName, "jira_custom_field.select", `"value":"B"`),
+					testAccCheckJiraIssueRawField(resourceName, "jira_custom_field.cascade", `"child":{"id":`),
+					testAccCheckJiraIssueRawField(resourceName, "jira_custom_field.user", `"displayName":`),
+				),
+			},
+			{
+				// The multi select list is cleared when it is removed
+				Config: testAccJiraIssueFieldsJSONConfig(rInt, `
+    (jira_custom_field.select.id)   = { value = "C" }
+    (jira_custom_field.cascade.id)  = { value = "A", child = { value = "A2" } }
+    (jira_custom_field.user.id)     = { name = jira_user.foo.name }
+    (jira_custom_field.date.id)     = "2024-06-01"
+    (jira_custom_field.number.id)   = 4
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueRawField(resourceName, "jira_custom_field.select", `"value":"C"`),
+					testAccCheckJiraIssueRawField(resourceName, "jira_custom_field.multi", `null`),
+					testAccCheckJiraIssueRawField(resourceName, "jira_custom_field.date", `"2024-06-01"`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields_json"},
+			},
+			{
+				Config: testAccJiraIssueFieldsJSONConfig(rInt, `
+    (jira_custom_field.select.id)   = { value = "Z" }
+`),
+				ExpectError: regexp.MustCompile(`Specify a valid value for select-field-\d+`),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssue_standardFields(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueStandardFieldsConfig(rInt, `
+  parent            = jira_issue.parent.issue_key
+  priority          = "High"
+  components        = [jira_component.a.name, jira_component.b.name]
+  fix_versions      = [jira_version.next.name]
+  affects_versions  = [jira_version.current.name]
+  due_date          = "2024-05-01"
+  environment       = "Linux"
+  original_estimate = "90m"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "High"),
+					resource.TestCheckResourceAttr(resourceName, "components.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "fix_versions.*", "jira_version.next", "name"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "affects_versions.*", "jira_version.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "due_date", "2024-05-01"),
+					resource.TestCheckResourceAttr(resourceName, "environment", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "original_estimate", "90m"),
+					resource.TestCheckResourceAttr(resourceName, "original_estimate_seconds", "5400"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_estimate", "1h 30m"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_estimate_seconds", "5400"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent", "jira_issue.parent", "issue_key"),
+				),
+			},
+			{
+				// Imported estimates are in the notation of JIRA
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_estimate"},
+			},
+			{
+				Config: testAccJiraIssueStandardFieldsConfig(rInt, `
+  parent             = jira_issue.parent.issue_key
+  priority           = "Low"
+  components         = [jira_component.a.name]
+  affects_versions   = [jira_version.current.name, jira_version.next.name]
+  original_estimate  = "1h 30m"
+  remaining_estimate = "30m"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "Low"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "components.*", "jira_component.a", "name"),
+					resource.TestCheckResourceAttr(resourceName, "components.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fix_versions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "affects_versions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "due_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "environment", ""),
+					resource.TestCheckResourceAttr(resourceName, "original_estimate", "1h 30m"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_estimate", "30m"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_estimate_seconds", "1800"),
+				),
+			},
+			{
+				Config: testAccJiraIssueStandardFieldsConfig(rInt, `
+  parent             = jira_issue.parent.issue_key
+  remaining_estimate = "30m"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "original_estimate", ""),
+					resource.TestCheckResourceAttr(resourceName, "remaining_estimate", "30m"),
+					resource.TestCheckResourceAttr(resourceName, "components.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "affects_versions.#", "0"),
+				),
+			},
+			{
+				// Sub-tasks can't be moved out of their parent
+				Config:      testAccJiraIssueStandardFieldsConfig(rInt, ""),
+				ExpectError: regexp.MustCompile(`Issue type is a sub-task but parent issue key or id not specified`),
+			},
+			{
+				Config: testAccJiraIssueStandardFieldsConfig(rInt, `
+  parent     = jira_issue.parent.issue_key
+  components = ["missing"]
+`),
+				ExpectError: regexp.MustCompile(`Component name 'missing' is not valid`),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssue_parent(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueParentConfig(rInt, "jira_issue.parent.issue_key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "parent", "jira_issue.parent", "issue_key"),
+				),
+			},
+			{
+				Config: testAccJiraIssueParentConfig(rInt, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parent", ""),
+					testAccCheckJiraIssueWithoutParent(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressEquivalentIssueFieldsJSON(t *testing.T) {
+	cases := []struct {
+		old, new   string
+		equivalent bool
+	}{
+		{`{"a":{"id":"1","self":"http://jira/1","value":"B"}}`, `{"a": {"value": "B"}}`, true},
+		{`{"a":[{"id":"2","value":"Y"},{"id":"1","value":"X"}]}`, `{"a":[{"value":"X"},{"value":"Y"}]}`, true},
+		{`{"a":{"value":"A","child":{"id":"3","value":"A1"}}}`, `{"a":{"value":"A","child":{"value":"A2"}}}`, false},
+		{`{"a":[{"value":"X"},{"value":"Y"}]}`, `{"a":[{"value":"X"}]}`, false},
+		{`{"a":3.5,"b":null}`, `{"b":null,"a":3.5}`, true},
+		{`{"a":"2024-05-01","b":"x"}`, `{"a":"2024-05-01"}`, false},
+		{`{"a":null}`, `{"a":{"value":"B"}}`, false},
+	}
+
+	for _, c := range cases {
+		if equivalent := suppressEquivalentIssueFieldsJSON("fields_json", c.old, c.new, nil); equivalent != c.equivalent {
+			t.Errorf("expected %s and %s to be equivalent: %t", c.old, c.new, c.equivalent)
+		}
+	}
+}
+
+func TestAccJiraIssue_adf(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueADFConfig(rInt, 3, `
+  description = <<-EOT
+    h2. Overview
+    Created using **Terraform**, see [the docs](https://example.org/docs).
+
+    - first
+    - second
+
+    {code:hcl}
+    resource "jira_issue" "foo" {}
+    {code}
+  EOT
+`, `
+  body = "Commented using *Terraform*"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraADF(resourceName, `"type":"heading"`),
+					testAccCheckJiraADF(resourceName, `"type":"bulletList"`),
+					testAccCheckJiraADF(resourceName, `"language":"hcl"`),
+					testAccCheckJiraADF(resourceName, `"href":"https://example.org/docs"`),
+					testAccCheckJiraADF("jira_comment.foo", `"type":"em"`),
+				),
+			},
+			{
+				Config: testAccJiraIssueADFConfig(rInt, 3, `
+  description_adf = jsonencode({
+    type    = "doc"
+    version = 1
+    content = [{ type = "paragraph", content = [{ type = "text", text = "Managed as document" }] }]
+  })
+`, `
+  body_adf = jsonencode({
+    type    = "doc"
+    version = 1
+    content = [{ type = "rule" }]
+  })
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraADF(resourceName, `"text":"Managed as document"`),
+					testAccCheckJiraADF("jira_comment.foo", `"type":"rule"`),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config: testAccJiraIssueADFConfig(rInt, 3, `
+  description = "Changed using Terraform"
+`, `
+  body = "Commented using Terraform"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraADF(resourceName, `"text":"Changed using Terraform"`),
+					resource.TestCheckResourceAttr(resourceName, "description", "Changed using Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "description_adf", ""),
+					resource.TestCheckResourceAttr("jira_comment.foo", "body", "Commented using Terraform"),
+				),
+			},
+			{
+				Config: testAccJiraIssueADFConfig(rInt, 2, `
+  description_adf = jsonencode({ type = "doc", version = 1, content = [] })
+`, `
+  body = "Commented using Terraform"
+`),
+				ExpectError: regexp.MustCompile(`description_adf requires api_version 3 of the provider`),
+			},
+		},
+	})
+}
+
+// testAccCheckJiraIssueRawField checks that the JSON value of the custom field
+// of the issue contains the given snippet
+func testAccCheckJiraIssueRawField(n string, field string, snippet string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+		fieldRs, ok := s.RootModule().Resources[field]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", field)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		issue := new(struct {
+			Fields map[string]json.RawMessage `json:"fields"`
+		})
+		err := request(context.Background(), client, "GET", issueEndpoint(rs.Primary.ID), nil, issue)
+		if err != nil {
+			return err
+		}
+
+		value := string(issue.Fields[fieldRs.Primary.ID])
+		if value == "" {
+			value = "null"
+		}
+		if !strings.Contains(value, snippet) {
+			return fmt.Errorf("Field %s of issue %s is %s, expected it to contain %s", fieldRs.Primary.ID, rs.Primary.ID, value, snippet)
+		}
+		return nil
+	}
+}
+
+func TestValidateIssueEstimate(t *testing.T) {
+	cases := []struct {
+		estimate string
+		valid    bool
+	}{
+		{"1w 2d 4h 30m", true},
+		{"90m", true},
+		{"1.5h", true},
+		{"", false},
+		{"2 days", false},
+		{"soon", false},
+	}
+
+	for _, c := range cases {
+		if _, errs := validateIssueEstimate(c.estimate, "original_estimate"); (len(errs) == 0) != c.valid {
+			t.Errorf("expected %q to be a valid estimate: %t", c.estimate, c.valid)
+		}
+	}
+}
+
+func TestTransitionIssueToStatus_withoutWorkflowPermission(t *testing.T) {
+	fake := newFakeJira()
+	defer fake.Close()
+	fake.workflows["jira"]["transitions"] = testFakeJiraWorkflowTransitions()
+
+	admin, developer := testFakeJiraClient(t, fake, "admin"), testFakeJiraClient(t, fake, "developer")
+	issue := testFakeJiraIssue(t, admin, developer)
+
+	ctx := context.Background()
+	if _, err := planIssueStatusPath(ctx, developer, issue, "Done"); err == nil {
+		t.Fatal("expected reading the workflow to be denied")
+	}
+	if err := transitionIssueToStatus(ctx, developer, issue, "done", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	testFakeJiraIssueStatus(t, developer, issue, "Done")
+}
+
+func TestTransitionIssueToStatus_teamManagedProject(t *testing.T) {
+	fake := newFakeJira()
+	defer fake.Close()
+
+	// The workflow of team-managed projects isn't reported by the workflow
+	// scheme API, so it must not be confused with the system workflow
+	fake.workflows["team"] = fakeObject{
+		"id":          fakeObject{"name": "team", "entityId": "team"},
+		"statuses":    []interface{}{fakeObject{"id": "1"}, fakeObject{"id": "3"}, fakeObject{"id": "10000"}},
+		"transitions": testFakeJiraWorkflowTransitions(),
+	}
+	fake.workflowSchemes["team"] = fakeObject{"id": "team", "defaultWorkflow": "team", "issueTypeMappings": fakeObject{}}
+
+	admin := testFakeJiraClient(t, fake, "admin")
+	issue := testFakeJiraIssue(t, admin, admin)
+	project := fake.projects.find("key", "PT")
+	project["workflowScheme"] = "team"
+	project["style"] = "next-gen"
+
+	ctx := context.Background()
+	if _, err := planIssueStatusPath(ctx, admin, issue, "Done"); !errors.Is(err, errIssueWorkflowUnknown) {
+		t.Fatalf("expected the workflow to be unknown, got %v", err)
+	}
+	if err := transitionIssueToStatus(ctx, admin, issue, "Done", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	testFakeJiraIssueStatus(t, admin, issue, "Done")
+}
+
+func TestIsIssueWorkflowUnreadable(t *testing.T) {
+	cases := []struct {
+		err        error
+		unreadable bool
+	}{
+		{&APIError{StatusCode: 401}, true},
+		{errors.Wrap(&APIError{StatusCode: 403}, "reading workflow failed"), true},
+		{errors.Wrapf(errIssueWorkflowUnknown, "workflow %q not found", "jira"), true},
+		{&APIError{StatusCode: 500}, false},
+		{&APIError{StatusCode: 400}, false},
+		{errors.New("connection refused"), false},
+	}
+
+	for _, c := range cases {
+		if unreadable := isIssueWorkflowUnreadable(c.err); unreadable != c.unreadable {
+			t.Errorf("expected the workflow to be unreadable after %q: %t", c.err, c.unreadable)
+		}
+	}
+}
+
+// testFakeJiraWorkflowTransitions returns the transitions of a workflow in
+// which Done can only be reached through In Progress
+func testFakeJiraWorkflowTransitions() []interface{} {
+	return []interface{}{
+		fakeObject{"id": "1", "name": "Create", "type": "initial", "from": []interface{}{}, "to": "1"},
+		fakeObject{"id": "11", "name": "Start Progress", "type": "directed", "from": []interface{}{"1"}, "to": "3"},
+		fakeObject{"id": "21", "name": "Done", "type": "directed", "from": []interface{}{"3"}, "to": "10000"},
+		fakeObject{"id": "31", "name": "Reopen", "type": "global", "from": []interface{}{}, "to": "1"},
+	}
+}
+
+func testFakeJiraClient(t *testing.T, fake *fakeJira, user string) *jira.Client {
+	transport := &jira.BasicAuthTransport{Username: user, Password: user}
+	client, err := jira.NewClient(transport.Client(), fake.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testFakeJiraIssue creates a project PT with an issue using the admin
+// client, and reads the issue using the given client
+func testFakeJiraIssue(t *testing.T, admin *jira.Client, client *jira.Client) *jira.Issue {
+	ctx := context.Background()
+	project := map[string]interface{}{"key": "PT", "name": "Transitions", "projectTypeKey": "business", "lead": "admin"}
+	if err := request(ctx, admin, "POST", projectAPIEndpoint, project, nil); err != nil {
+		t.Fatal(err)
+	}
+	created := new(jira.Issue)
+	fields := map[string]interface{}{
+		"project":   map[string]string{"key": "PT"},
+		"issuetype": map[string]string{"name": "Task"},
+		"summary":   "Moved along the transitions",
+	}
+	if err := request(ctx, admin, "POST", issueAPIEndpoint, map[string]interface{}{"fields": fields}, created); err != nil {
+		t.Fatal(err)
+	}
+
+	issue, _, err := client.Issue.GetWithContext(ctx, created.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issue
+}
+
+func testFakeJiraIssueStatus(t *testing.T, client *jira.Client, issue *jira.Issue, status string) {
+	issue, _, err := client.Issue.GetWithContext(context.Background(), issue.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Fields.Status.Name != status {
+		t.Errorf("expected issue %s to be in status %s, it is in status %s", issue.Key, status, issue.Fields.Status.Name)
+	}
+}
+
+// testAccCheckJiraADF checks that the document of the description of an
+// issue or the body of a comment, as returned by version 3 of the API,
+// contains the given snippet
+func testAccCheckJiraADF(n string, snippet string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		var document json.RawMessage
+		if rs.Type == "jira_comment" {
+			comment := new(struct {
+				Body json.RawMessage `json:"body"`
+			})
+			err := request(context.Background(), client, "GET", commentV3Endpoint(rs.Primary.Attributes["issue_key"], rs.Primary.ID), nil, comment)
+			if err != nil {
+				return err
+			}
+			document = comment.Body
+		} else {
+			issue := new(struct {
+				Fields struct {
+					Description json.RawMessage `json:"description"`
+				} `json:"fields"`
+			})
+			err := request(context.Background(), client, "GET", issueV3Endpoint(rs.Primary.ID), nil, issue)
+			if err != nil {
+				return err
+			}
+			document = issue.Fields.Description
+		}
+
+		if !strings.Contains(string(document), snippet) {
+			return fmt.Errorf("Document of %s is %s, expected it to contain %s", rs.Primary.ID, document, snippet)
+		}
+		return nil
+	}
+}
+
+// testAccCheckJiraIssueComments checks the number of comments of the issue
+func testAccCheckJiraIssueComments(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		issue := new(jira.Issue)
+		err := request(context.Background(), client, "GET", issueEndpoint(rs.Primary.ID), nil, issue)
+		if err != nil {
+			return err
+		}
+		if issue.Fields.Comments == nil || len(issue.Fields.Comments.Comments) != count {
+			return fmt.Errorf("Issue %s does not have %d comments", rs.Primary.ID, count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckJiraIssueWithoutParent(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		issue := new(jira.Issue)
+		err := request(context.Background(), client, "GET", issueEndpoint(rs.Primary.ID), nil, issue)
+		if err != nil {
+			return err
+		}
+		if issue.Fields.Parent != nil {
+			return fmt.Errorf("Issue %s still has the parent %s", rs.Primary.ID, issue.Fields.Parent.Key)
+		}
+		return nil
+	}
+}
+
 func testAccCheckJiraIssueDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Config).jiraClient
 
@@ -131,3 +783,337 @@ resource "jira_issue" "example" {
 }
 `, rInt, rInt, rInt%100000)
 }
+
+// The workflow leads from Open through In Progress and review to Done, the
+// transitions to Done ask for a resolution
+func testAccJiraIssueStatusConfig(rInt int, issue string) string {
+	return fmt.Sprintf(`
+resource "jira_status" "review" {
+  name     = "review-status-%d"
+  category = "IN_PROGRESS"
+}
+
+resource "jira_screen" "close" {
+  name = "close-screen-%d"
+
+  tab {
+    name = "Resolution"
+  }
+}
+
+resource "jira_workflow" "foo" {
+  name = "foo-workflow-%d"
+
+  status {
+    status_id = "1"
+  }
+
+  status {
+    status_id = "3"
+  }
+
+  status {
+    status_id = jira_status.review.id
+  }
+
+  status {
+    status_id = "10000"
+  }
+
+  transition {
+    name = "Create"
+    type = "initial"
+    to   = "1"
+  }
+
+  transition {
+    name = "Start Progress"
+    from = ["1"]
+    to   = "3"
+  }
+
+  transition {
+    name = "Review"
+    from = ["3"]
+    to   = jira_status.review.id
+  }
+
+  transition {
+    name      = "Close"
+    from      = [jira_status.review.id]
+    to        = "10000"
+    screen_id = jira_screen.close.id
+  }
+
+  transition {
+    name      = "Resolve"
+    from      = ["10000"]
+    to        = "10000"
+    screen_id = jira_screen.close.id
+  }
+
+  transition {
+    name = "Back to Review"
+    type = "global"
+    to   = jira_status.review.id
+  }
+}
+
+resource "jira_workflow_scheme" "foo" {
+  name             = "foo-scheme-%d"
+  default_workflow = jira_workflow.foo.name
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  workflow_scheme      = jira_workflow_scheme.foo.id
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+%s
+}
+`, rInt, rInt, rInt, rInt, rInt, rInt, rInt%100000, issue)
+}
+
+func testAccJiraIssueFieldsJSONConfig(rInt int, fields string) string {
+	return fmt.Sprintf(`
+resource "jira_custom_field" "select" {
+  name = "select-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+}
+
+resource "jira_custom_field_context" "select" {
+  field_id = jira_custom_field.select.id
+  name     = "Default"
+}
+
+resource "jira_custom_field_option" "select" {
+  field_id   = jira_custom_field.select.id
+  context_id = jira_custom_field_context.select.context_id
+
+  option {
+    value = "B"
+  }
+
+  option {
+    value = "C"
+  }
+}
+
+resource "jira_custom_field" "multi" {
+  name = "multi-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:multiselect"
+}
+
+resource "jira_custom_field_context" "multi" {
+  field_id = jira_custom_field.multi.id
+  name     = "Default"
+}
+
+resource "jira_custom_field_option" "multi" {
+  field_id   = jira_custom_field.multi.id
+  context_id = jira_custom_field_context.multi.context_id
+
+  option {
+    value = "X"
+  }
+
+  option {
+    value = "Y"
+  }
+}
+
+resource "jira_custom_field" "cascade" {
+  name = "cascade-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect"
+}
+
+resource "jira_custom_field_context" "cascade" {
+  field_id = jira_custom_field.cascade.id
+  name     = "Default"
+}
+
+resource "jira_custom_field_option" "cascade" {
+  field_id   = jira_custom_field.cascade.id
+  context_id = jira_custom_field_context.cascade.context_id
+
+  option {
+    value = "A"
+
+    child {
+      value = "A1"
+    }
+
+    child {
+      value = "A2"
+    }
+  }
+}
+
+resource "jira_custom_field" "user" {
+  name = "user-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:userpicker"
+}
+
+resource "jira_custom_field" "date" {
+  name = "date-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:datepicker"
+}
+
+resource "jira_custom_field" "number" {
+  name = "number-field-%d"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:float"
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+
+  fields_json = jsonencode({
+%s
+  })
+
+  depends_on = [jira_custom_field_option.select, jira_custom_field_option.multi, jira_custom_field_option.cascade]
+}
+`, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt, rInt%100000, fields)
+}
+
+func testAccJiraIssueADFConfig(rInt int, apiVersion int, issue string, comment string) string {
+	return fmt.Sprintf(`
+provider "jira" {
+  api_version = %d
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+%s
+}
+
+resource "jira_comment" "foo" {
+  issue_key = jira_issue.foo.issue_key
+%s
+}
+`, apiVersion, rInt, rInt, rInt%100000, issue, comment)
+}
+
+// On Jira Cloud, the parent of a standard issue is its epic
+func testAccJiraIssueParentConfig(rInt int, parent string) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+}
+
+resource "jira_issue" "parent" {
+  issue_type  = "Epic"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+  parent      = %s
+}
+`, rInt, rInt, rInt%100000, parent)
+}
+
+func testAccJiraIssueStandardFieldsConfig(rInt int, fields string) string {
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+}
+
+resource "jira_component" "a" {
+  name        = "component-a-%d"
+  project_key = jira_project.foo.key
+}
+
+resource "jira_component" "b" {
+  name        = "component-b-%d"
+  project_key = jira_project.foo.key
+}
+
+resource "jira_version" "current" {
+  name        = "current-version-%d"
+  project_key = jira_project.foo.key
+}
+
+resource "jira_version" "next" {
+  name        = "next-version-%d"
+  project_key = jira_project.foo.key
+}
+
+resource "jira_issue" "parent" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Sub-task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+%s
+}
+`, rInt, rInt, rInt%100000, rInt, rInt, rInt, rInt, fields)
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -19,12 +21,18 @@ type IssueTypeRequest struct {
 
 func resourceIssueType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIssueTypeCreate,
-		Read:   resourceIssueTypeRead,
-		Update: resourceIssueTypeUpdate,
-		Delete: resourceIssueTypeDelete,
+		CreateContext: resourceIssueTypeCreate,
+		ReadContext:   resourceIssueTypeRead,
+		UpdateContext: resourceIssueTypeUpdate,
+		DeleteContext: resourceIssueTypeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +59,7 @@ func resourceIssueType() *schema.Resource {
 }
 
 // resourceIssueTypeCreate creates a new jira issue using the jira api
-func resourceIssueTypeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	issueType := new(IssueTypeRequest)
@@ -65,29 +73,33 @@ func resourceIssueTypeCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	returnedIssueType := new(jira.IssueType)
-	err := request(config.jiraClient, "POST", issueTypeAPIEndpoint, issueType, returnedIssueType)
+	err := request(ctx, config.jiraClient, "POST", issueTypeAPIEndpoint, issueType, returnedIssueType)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira issue type failed", nil)
 	}
 
 	d.SetId(returnedIssueType.ID)
 
-	resourceIssueTypeUpdate(d, m)
+	resourceIssueTypeUpdate(ctx, d, m)
 
-	return resourceIssueTypeRead(d, m)
+	return resourceIssueTypeRead(ctx, d, m)
 }
 
 // resourceIssueTypeRead reads issue details using jira api
-func resourceIssueTypeRead(d *schema.ResourceData, m interface{}) error {
+func resourceIssueTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, d.Id())
 
 	issueType := new(jira.IssueType)
-	err := request(config.jiraClient, "GET", urlStr, nil, issueType)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, issueType)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue type failed", nil)
 	}
 
 	d.Set("name", issueType.Name)
@@ -99,7 +111,7 @@ func resourceIssueTypeRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceIssueTypeUpdate updates jira issue using jira api
-func resourceIssueTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceIssueTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	issueType := new(IssueTypeRequest)
@@ -111,25 +123,25 @@ func resourceIssueTypeUpdate(d *schema.ResourceData, m interface{}) error {
 	urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, d.Id())
 	returnedIssueType := new(jira.IssueType)
 
-	err := request(config.jiraClient, "PUT", urlStr, issueType, returnedIssueType)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, issueType, returnedIssueType)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira issue type failed", nil)
 	}
 
-	return resourceIssueTypeRead(d, m)
+	return resourceIssueTypeRead(ctx, d, m)
 }
 
 // resourceIssueTypeDelete deletes jira issue using the jira api
-func resourceIssueTypeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceIssueTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira issue type failed", nil)
 	}
 
 	return nil
@@ -0,0 +1,270 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// IssueTypeScheme The struct sent to and returned by the JIRA instance to manage issue type schemes
+type IssueTypeScheme struct {
+	ID                 string   `json:"id,omitempty"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	DefaultIssueTypeID string   `json:"defaultIssueTypeId,omitempty"`
+	IssueTypeIDs       []string `json:"issueTypeIds,omitempty"`
+	IsDefault          bool     `json:"isDefault,omitempty"`
+}
+
+// resourceIssueTypeScheme is used to define a JIRA issue type scheme
+func resourceIssueTypeScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueTypeSchemeCreate,
+		ReadContext:   resourceIssueTypeSchemeRead,
+		UpdateContext: resourceIssueTypeSchemeUpdate,
+		DeleteContext: resourceIssueTypeSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates an issue type scheme, which defines the issue types available in a project. " +
+			"The ID can be used as issue_type_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the issue type scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the issue type scheme",
+			},
+			"issue_type_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the issue types of the scheme, in the order they are shown. " +
+					"Issue types added outside of Terraform are removed",
+			},
+			"default_issue_type_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the issue type selected by default when creating issues. Needs to be part of issue_type_ids",
+			},
+		},
+	}
+}
+
+func issueTypeSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", issueTypeSchemeAPIEndpoint, id)
+}
+
+// getIssueTypeScheme returns the issue type scheme including its issue types,
+// or nil if it does not exist
+func getIssueTypeScheme(ctx context.Context, client *jira.Client, id string) (*IssueTypeScheme, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	var scheme *IssueTypeScheme
+	err := requestPages(ctx, client, issueTypeSchemeAPIEndpoint, query, func(value json.RawMessage) error {
+		scheme = new(IssueTypeScheme)
+		return json.Unmarshal(value, scheme)
+	})
+	if err != nil || scheme == nil {
+		return nil, err
+	}
+
+	query = url.Values{}
+	query.Set("issueTypeSchemeId", id)
+
+	scheme.IssueTypeIDs = []string{}
+	err = requestPages(ctx, client, issueTypeSchemeAPIEndpoint+"/mapping", query, func(value json.RawMessage) error {
+		mapping := new(struct {
+			IssueTypeID string `json:"issueTypeId"`
+		})
+		if err := json.Unmarshal(value, mapping); err != nil {
+			return err
+		}
+		scheme.IssueTypeIDs = append(scheme.IssueTypeIDs, mapping.IssueTypeID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// getProjectIssueTypeScheme returns the issue type scheme of the project, or
+// nil if JIRA doesn't report it
+func getProjectIssueTypeScheme(ctx context.Context, client *jira.Client, projectID string) (*IssueTypeScheme, error) {
+	query := url.Values{}
+	query.Set("projectId", projectID)
+
+	var scheme *IssueTypeScheme
+	err := requestPages(ctx, client, issueTypeSchemeAPIEndpoint+"/project", query, func(value json.RawMessage) error {
+		association := new(struct {
+			IssueTypeScheme IssueTypeScheme `json:"issueTypeScheme"`
+		})
+		if err := json.Unmarshal(value, association); err != nil {
+			return err
+		}
+		scheme = &association.IssueTypeScheme
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// assignProjectIssueTypeScheme makes the issue types of the scheme available
+// in the project
+func assignProjectIssueTypeScheme(ctx context.Context, client *jira.Client, projectID string, schemeID int) error {
+	body := map[string]string{
+		"issueTypeSchemeId": strconv.Itoa(schemeID),
+		"projectId":         projectID,
+	}
+	return request(ctx, client, "PUT", issueTypeSchemeAPIEndpoint+"/project", body, nil)
+}
+
+// resourceIssueTypeSchemeCreate creates a new jira issue type scheme using the jira api
+func resourceIssueTypeSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &IssueTypeScheme{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		DefaultIssueTypeID: d.Get("default_issue_type_id").(string),
+		IssueTypeIDs:       expandStringList(d.Get("issue_type_ids").([]interface{})),
+	}
+
+	returnedScheme := new(struct {
+		IssueTypeSchemeID string `json:"issueTypeSchemeId"`
+	})
+	err := request(ctx, config.jiraClient, "POST", issueTypeSchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue type scheme failed", nil)
+	}
+
+	d.SetId(returnedScheme.IssueTypeSchemeID)
+
+	return resourceIssueTypeSchemeRead(ctx, d, m)
+}
+
+// resourceIssueTypeSchemeRead reads issue type scheme details using jira api
+func resourceIssueTypeSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme, err := getIssueTypeScheme(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue type scheme failed", nil)
+	}
+	if scheme == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("default_issue_type_id", scheme.DefaultIssueTypeID)
+	d.Set("issue_type_ids", scheme.IssueTypeIDs)
+
+	return nil
+}
+
+// resourceIssueTypeSchemeUpdate updates jira issue type scheme using jira api
+func resourceIssueTypeSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	endpoint := issueTypeSchemeEndpoint(d.Id())
+
+	o, n := d.GetChange("issue_type_ids")
+	oldIDs := expandStringList(o.([]interface{}))
+	newIDs := expandStringList(n.([]interface{}))
+
+	// Issue types are added before the default is changed, as the default
+	// needs to be part of the scheme
+	var added []string
+	for _, id := range newIDs {
+		if !containsString(oldIDs, id) {
+			added = append(added, id)
+		}
+	}
+	if len(added) > 0 {
+		body := map[string][]string{"issueTypeIds": added}
+		err := request(ctx, config.jiraClient, "PUT", endpoint+"/issuetype", body, nil)
+		if err != nil {
+			return errorDiagnostics(err, "adding issue types to jira issue type scheme failed", nil)
+		}
+	}
+
+	if d.HasChanges("name", "description", "default_issue_type_id") {
+		scheme := &IssueTypeScheme{
+			Name:               d.Get("name").(string),
+			Description:        d.Get("description").(string),
+			DefaultIssueTypeID: d.Get("default_issue_type_id").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", endpoint, scheme, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira issue type scheme failed", nil)
+		}
+	}
+
+	for _, id := range oldIDs {
+		if containsString(newIDs, id) {
+			continue
+		}
+		err := request(ctx, config.jiraClient, "DELETE", fmt.Sprintf("%s/issuetype/%s", endpoint, id), nil, nil)
+		if err != nil && !errors.Is(err, ResourceNotFoundError) {
+			return errorDiagnostics(err, "removing issue type from jira issue type scheme failed", nil)
+		}
+	}
+
+	if d.HasChange("issue_type_ids") && len(newIDs) > 1 {
+		move := map[string]interface{}{
+			"issueTypeIds": newIDs,
+			"position":     "First",
+		}
+		err := request(ctx, config.jiraClient, "PUT", endpoint+"/issuetype/move", move, nil)
+		if err != nil {
+			return errorDiagnostics(err, "ordering issue types of jira issue type scheme failed", nil)
+		}
+	}
+
+	return resourceIssueTypeSchemeRead(ctx, d, m)
+}
+
+// resourceIssueTypeSchemeDelete deletes jira issue type scheme using the jira api
+func resourceIssueTypeSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", issueTypeSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue type scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueTypeScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_type_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueTypeSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeSchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeSchemeExists(resourceName),
+					testAccStoreResourceID(resourceName, &id),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.0", "jira_issue_type.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_issue_type_id", "jira_issue_type.foo", "id"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "issue_type_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueTypeSchemeConfig(rInt, "bar", "bar", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.0", "jira_issue_type.bar", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.1", "jira_issue_type.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_issue_type_id", "jira_issue_type.bar", "id"),
+				),
+			},
+			{
+				// Issue types added outside of Terraform are removed again
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					issueTypes := []jira.IssueType{}
+					err := request(context.Background(), jiraClient, "GET", issueTypeAPIEndpoint, nil, &issueTypes)
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, issueType := range issueTypes {
+						if issueType.Name == "Bug" {
+							body := map[string][]string{"issueTypeIds": {issueType.ID}}
+							err := request(context.Background(), jiraClient, "PUT", issueTypeSchemeEndpoint(id)+"/issuetype", body, nil)
+							if err != nil {
+								t.Fatal(err)
+							}
+						}
+					}
+				},
+				Config: testAccJiraIssueTypeSchemeConfig(rInt, "bar", "bar", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeSchemeTypes(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueTypeScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_type_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueTypeSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeSchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", issueTypeSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueTypeSchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeSchemeExists(resourceName),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "issue_type_scheme", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueTypeSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_type_scheme" {
+			continue
+		}
+
+		scheme, err := getIssueTypeScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme != nil {
+			return fmt.Errorf("Issue type scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueTypeSchemeExists(n string) resource.TestCheckFunc {
+	return testAccCheckJiraIssueTypeSchemeTypes(n, -1)
+}
+
+// testAccCheckJiraIssueTypeSchemeTypes checks that the scheme exists and has
+// count issue types, unless count is negative
+func testAccCheckJiraIssueTypeSchemeTypes(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No issue type scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		scheme, err := getIssueTypeScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme == nil {
+			return fmt.Errorf("Issue type scheme %q does not exist", rs.Primary.ID)
+		}
+		if count >= 0 && len(scheme.IssueTypeIDs) != count {
+			return fmt.Errorf("Issue type scheme %q has %d issue types, expected %d", rs.Primary.ID, len(scheme.IssueTypeIDs), count)
+		}
+		return nil
+	}
+}
+
+func testAccJiraIssueTypeSchemeConfig(rInt int, name string, first string, second string) string {
+	return fmt.Sprintf(`
+resource "jira_issue_type" "foo" {
+  name = "foo-type-%d"
+}
+
+resource "jira_issue_type" "bar" {
+  name = "bar-type-%d"
+}
+
+resource "jira_issue_type_scheme" "foo" {
+  name                  = "%s-scheme-%d"
+  description           = "Created by Terraform"
+  issue_type_ids        = [jira_issue_type.%s.id, jira_issue_type.%s.id]
+  default_issue_type_id = jira_issue_type.%s.id
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_type_scheme    = jira_issue_type_scheme.foo.id
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = jira_issue_type.foo.name
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+}
+`, rInt, rInt, name, rInt, first, second, first, rInt, rInt, rInt%100000)
+}
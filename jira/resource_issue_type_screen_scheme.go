@@ -0,0 +1,295 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// IssueTypeScreenScheme The struct sent to and returned by the JIRA instance to manage issue type screen schemes
+type IssueTypeScreenScheme struct {
+	ID                string                         `json:"id,omitempty"`
+	Name              string                         `json:"name"`
+	Description       string                         `json:"description"`
+	IssueTypeMappings []IssueTypeScreenSchemeMapping `json:"issueTypeMappings,omitempty"`
+}
+
+// IssueTypeScreenSchemeMapping maps an issue type to a screen scheme. The
+// issue type default applies to all issue types without mapping.
+type IssueTypeScreenSchemeMapping struct {
+	IssueTypeID    string `json:"issueTypeId"`
+	ScreenSchemeID string `json:"screenSchemeId"`
+}
+
+// resourceIssueTypeScreenScheme is used to define a JIRA issue type screen scheme
+func resourceIssueTypeScreenScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueTypeScreenSchemeCreate,
+		ReadContext:   resourceIssueTypeScreenSchemeRead,
+		UpdateContext: resourceIssueTypeScreenSchemeUpdate,
+		DeleteContext: resourceIssueTypeScreenSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates an issue type screen scheme, which maps issue types to screen schemes. " +
+			"The ID can be used as issue_type_screen_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the issue type screen scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the issue type screen scheme",
+			},
+			"default_screen_scheme_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the screen scheme used by issue types without mapping",
+			},
+			"issue_type_mappings": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Maps the IDs of issue types to the IDs of their screen schemes",
+			},
+		},
+	}
+}
+
+func issueTypeScreenSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", issueTypeScreenSchemeAPIEndpoint, id)
+}
+
+// expandIssueTypeScreenSchemeMappings returns the mappings sorted by issue type
+func expandIssueTypeScreenSchemeMappings(mappings map[string]string) []IssueTypeScreenSchemeMapping {
+	result := make([]IssueTypeScreenSchemeMapping, 0, len(mappings))
+	for issueTypeID, screenSchemeID := range mappings {
+		result = append(result, IssueTypeScreenSchemeMapping{IssueTypeID: issueTypeID, ScreenSchemeID: screenSchemeID})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].IssueTypeID < result[j].IssueTypeID })
+	return result
+}
+
+// getIssueTypeScreenScheme returns the issue type screen scheme including its
+// mappings, or nil if it does not exist
+func getIssueTypeScreenScheme(ctx context.Context, client *jira.Client, id string) (*IssueTypeScreenScheme, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	var scheme *IssueTypeScreenScheme
+	err := requestPages(ctx, client, issueTypeScreenSchemeAPIEndpoint, query, func(value json.RawMessage) error {
+		scheme = new(IssueTypeScreenScheme)
+		return json.Unmarshal(value, scheme)
+	})
+	if err != nil || scheme == nil {
+		return nil, err
+	}
+
+	query = url.Values{}
+	query.Set("issueTypeScreenSchemeId", id)
+
+	err = requestPages(ctx, client, issueTypeScreenSchemeAPIEndpoint+"/mapping", query, func(value json.RawMessage) error {
+		mapping := IssueTypeScreenSchemeMapping{}
+		if err := json.Unmarshal(value, &mapping); err != nil {
+			return err
+		}
+		scheme.IssueTypeMappings = append(scheme.IssueTypeMappings, mapping)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// getProjectIssueTypeScreenScheme returns the issue type screen scheme of the
+// project, or nil if JIRA doesn't report it
+func getProjectIssueTypeScreenScheme(ctx context.Context, client *jira.Client, projectID string) (*IssueTypeScreenScheme, error) {
+	query := url.Values{}
+	query.Set("projectId", projectID)
+
+	var scheme *IssueTypeScreenScheme
+	err := requestPages(ctx, client, issueTypeScreenSchemeAPIEndpoint+"/project", query, func(value json.RawMessage) error {
+		association := new(struct {
+			IssueTypeScreenScheme IssueTypeScreenScheme `json:"issueTypeScreenScheme"`
+		})
+		if err := json.Unmarshal(value, association); err != nil {
+			return err
+		}
+		scheme = &association.IssueTypeScreenScheme
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// assignProjectIssueTypeScreenScheme makes the project use the screens of the
+// scheme
+func assignProjectIssueTypeScreenScheme(ctx context.Context, client *jira.Client, projectID string, schemeID int) error {
+	body := map[string]string{
+		"issueTypeScreenSchemeId": strconv.Itoa(schemeID),
+		"projectId":               projectID,
+	}
+	return request(ctx, client, "PUT", issueTypeScreenSchemeAPIEndpoint+"/project", body, nil)
+}
+
+// resourceIssueTypeScreenSchemeCreate creates a new jira issue type screen scheme using the jira api
+func resourceIssueTypeScreenSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &IssueTypeScreenScheme{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IssueTypeMappings: append([]IssueTypeScreenSchemeMapping{{
+			IssueTypeID:    "default",
+			ScreenSchemeID: strconv.Itoa(d.Get("default_screen_scheme_id").(int)),
+		}}, expandIssueTypeScreenSchemeMappings(expandStringMap(d.Get("issue_type_mappings")))...),
+	}
+
+	returnedScheme := new(IssueTypeScreenScheme)
+	err := request(ctx, config.jiraClient, "POST", issueTypeScreenSchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue type screen scheme failed", nil)
+	}
+
+	d.SetId(returnedScheme.ID)
+
+	return resourceIssueTypeScreenSchemeRead(ctx, d, m)
+}
+
+// resourceIssueTypeScreenSchemeRead reads issue type screen scheme details using jira api
+func resourceIssueTypeScreenSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme, err := getIssueTypeScreenScheme(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue type screen scheme failed", nil)
+	}
+	if scheme == nil {
+		d.SetId("")
+		return nil
+	}
+
+	mappings := make(map[string]string)
+	for _, mapping := range scheme.IssueTypeMappings {
+		if mapping.IssueTypeID == "default" {
+			id, _ := strconv.Atoi(mapping.ScreenSchemeID)
+			d.Set("default_screen_scheme_id", id)
+			continue
+		}
+		mappings[mapping.IssueTypeID] = mapping.ScreenSchemeID
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("issue_type_mappings", mappings)
+
+	return nil
+}
+
+// resourceIssueTypeScreenSchemeUpdate updates jira issue type screen scheme using jira api
+func resourceIssueTypeScreenSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	endpoint := issueTypeScreenSchemeEndpoint(d.Id())
+
+	if d.HasChanges("name", "description") {
+		scheme := &IssueTypeScreenScheme{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", endpoint, scheme, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira issue type screen scheme failed", nil)
+		}
+	}
+
+	if d.HasChange("default_screen_scheme_id") {
+		body := map[string]string{
+			"screenSchemeId": strconv.Itoa(d.Get("default_screen_scheme_id").(int)),
+		}
+		err := request(ctx, config.jiraClient, "PUT", endpoint+"/mapping/default", body, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating default of jira issue type screen scheme failed", nil)
+		}
+	}
+
+	if d.HasChange("issue_type_mappings") {
+		o, n := d.GetChange("issue_type_mappings")
+		oldMappings := expandStringMap(o)
+		newMappings := expandStringMap(n)
+
+		// Changed mappings are removed and added again
+		removed := []string{}
+		for issueTypeID, screenSchemeID := range oldMappings {
+			if newMappings[issueTypeID] != screenSchemeID {
+				removed = append(removed, issueTypeID)
+			}
+		}
+		if len(removed) > 0 {
+			sort.Strings(removed)
+			body := map[string][]string{"issueTypeIds": removed}
+			err := request(ctx, config.jiraClient, "POST", endpoint+"/mapping/remove", body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "removing mappings of jira issue type screen scheme failed", nil)
+			}
+		}
+
+		added := make(map[string]string)
+		for issueTypeID, screenSchemeID := range newMappings {
+			if oldMappings[issueTypeID] != screenSchemeID {
+				added[issueTypeID] = screenSchemeID
+			}
+		}
+		if len(added) > 0 {
+			body := map[string]interface{}{
+				"issueTypeMappings": expandIssueTypeScreenSchemeMappings(added),
+			}
+			err := request(ctx, config.jiraClient, "PUT", endpoint+"/mapping", body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "adding mappings of jira issue type screen scheme failed", nil)
+			}
+		}
+	}
+
+	return resourceIssueTypeScreenSchemeRead(ctx, d, m)
+}
+
+// resourceIssueTypeScreenSchemeDelete deletes jira issue type screen scheme using the jira api
+func resourceIssueTypeScreenSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", issueTypeScreenSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue type screen scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueTypeScreenScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_type_screen_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueTypeScreenSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeScreenSchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeScreenSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_screen_scheme_id", "jira_screen_scheme.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.%", "1"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "issue_type_screen_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueTypeScreenSchemeConfig(rInt, "bar", "bar", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeScreenSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_screen_scheme_id", "jira_screen_scheme.bar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueTypeScreenScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_type_screen_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueTypeScreenSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeScreenSchemeBaseConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", issueTypeScreenSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueTypeScreenSchemeBaseConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeScreenSchemeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueTypeScreenSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_type_screen_scheme" {
+			continue
+		}
+
+		scheme, err := getIssueTypeScreenScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme != nil {
+			return fmt.Errorf("Issue type screen scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueTypeScreenSchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No issue type screen scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		scheme, err := getIssueTypeScreenScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme == nil {
+			return fmt.Errorf("Issue type screen scheme %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// The scheme foo uses the screen scheme defaultScheme by default and maps the
+// issue type to the screen scheme mappedScheme
+func testAccJiraIssueTypeScreenSchemeBaseConfig(rInt int, name string, defaultScheme string, mappedScheme string) string {
+	return fmt.Sprintf(`
+resource "jira_issue_type" "foo" {
+  name = "screen-type-%d"
+}
+
+resource "jira_screen" "foo" {
+  name = "foo-screen-%d"
+
+  tab {
+    name   = "General"
+    fields = ["summary"]
+  }
+}
+
+resource "jira_screen_scheme" "foo" {
+  name              = "foo-screen-scheme-%d"
+  default_screen_id = jira_screen.foo.id
+}
+
+resource "jira_screen_scheme" "bar" {
+  name              = "bar-screen-scheme-%d"
+  default_screen_id = jira_screen.foo.id
+}
+
+resource "jira_issue_type_screen_scheme" "foo" {
+  name                     = "%s-scheme-%d"
+  description              = "Created by Terraform"
+  default_screen_scheme_id = jira_screen_scheme.%s.id
+
+  issue_type_mappings = {
+    (jira_issue_type.foo.id) = jira_screen_scheme.%s.id
+  }
+}
+`, rInt, rInt, rInt, rInt, name, rInt, defaultScheme, mappedScheme)
+}
+
+func testAccJiraIssueTypeScreenSchemeConfig(rInt int, name string, defaultScheme string, mappedScheme string) string {
+	return testAccJiraIssueTypeScreenSchemeBaseConfig(rInt, name, defaultScheme, mappedScheme) + fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                     = "foo-name-%d"
+  key                      = "PX%d"
+  lead                     = jira_user.foo.name
+  project_type_key         = "software"
+  project_template_key     = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  issue_type_screen_scheme = jira_issue_type_screen_scheme.foo.id
+}
+`, rInt, rInt, rInt%100000)
+}
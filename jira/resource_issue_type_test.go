@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraIssueType_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_type.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_subtask", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueType_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_type.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, id)
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueTypeConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueTypeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraIssueTypeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_type" {
+			continue
+		}
+
+		urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Issue type %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraIssueTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No issue type ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		urlStr := fmt.Sprintf("%s/%s", issueTypeAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if err != nil {
+			return fmt.Errorf("Issue type %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraIssueTypeConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_issue_type" "foo" {
+  name        = "foo-type-%d"
+  description = "Created by Terraform"
+}`, rInt)
+}
@@ -0,0 +1,112 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// resourceIssueWatcher is used to add a single watcher to a JIRA issue,
+// leaving other watchers untouched
+func resourceIssueWatcher() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueWatcherCreate,
+		ReadContext:   resourceIssueWatcherRead,
+		DeleteContext: resourceIssueWatcherDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Adds a user to the watchers of an issue",
+
+		Schema: map[string]*schema.Schema{
+			"issue_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitiveSuppressFunc,
+				Description:      "Name of the user, or the account ID on Jira Cloud",
+			},
+		},
+	}
+}
+
+// resourceIssueWatcherCreate adds the watcher using the jira api
+func resourceIssueWatcherCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+	username := d.Get("username").(string)
+
+	err := addIssueWatcher(ctx, config.jiraClient, issueKey, username)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue watcher failed", nil)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", issueKey, username))
+
+	return resourceIssueWatcherRead(ctx, d, m)
+}
+
+// resourceIssueWatcherRead checks whether the user still watches the issue using jira api
+func resourceIssueWatcherRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	components := strings.SplitN(d.Id(), ":", 2)
+	if len(components) != 2 {
+		return diag.Errorf("invalid jira issue watcher ID %q, expected <issue_key>:<username>", d.Id())
+	}
+	issueKey, username := components[0], components[1]
+
+	watchers, err := getIssueWatchers(ctx, config.jiraClient, issueKey)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue watcher failed", nil)
+	}
+
+	d.Set("issue_key", issueKey)
+	d.Set("username", username)
+
+	if findIssueWatcher(watchers, username) == nil {
+		// The user no longer watches the issue
+		d.SetId("")
+	}
+	return nil
+}
+
+// resourceIssueWatcherDelete removes the watcher using the jira api
+func resourceIssueWatcherDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+
+	watchers, err := getIssueWatchers(ctx, config.jiraClient, issueKey)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue watcher failed", nil)
+	}
+
+	if watcher := findIssueWatcher(watchers, d.Get("username").(string)); watcher != nil {
+		err := removeIssueWatcher(ctx, config.jiraClient, issueKey, watcher)
+		if err != nil {
+			return errorDiagnostics(err, "deleting jira issue watcher failed", nil)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueWatcher_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_watcher.foo"
+	reporter := fmt.Sprintf("watcher-a-%d", rInt)
+	other := fmt.Sprintf("watcher-b-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueWatchersDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Other watchers, like the reporter, are kept
+				Config: testAccJiraIssueWatcherConfig(rInt, "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, reporter, other),
+					resource.TestCheckResourceAttr(resourceName, "username", other),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// JIRA ignores the case of user names
+				Config: testAccJiraIssueWatcherConfig(rInt, "upper(jira_user.b.name)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, reporter, other),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueWatcher_drift(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_watcher.foo"
+	reporter := fmt.Sprintf("watcher-a-%d", rInt)
+	other := fmt.Sprintf("watcher-b-%d", rInt)
+	var id, issueKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueWatchersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueWatcherConfig(rInt, "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					issueKey = strings.SplitN(id, ":", 2)[0]
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := removeIssueWatcher(context.Background(), jiraClient, issueKey, &jira.Watcher{Name: other})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueWatcherConfig(rInt, "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, reporter, other),
+				),
+			},
+			{
+				// Deleting the resource keeps the other watchers
+				Config: testAccJiraIssueWatchersBaseConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchedBy(&issueKey, reporter),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueWatcherConfig(rInt int, username string) string {
+	return testAccJiraIssueWatchersBaseConfig(rInt) + fmt.Sprintf(`
+resource "jira_issue_watcher" "foo" {
+  issue_key = jira_issue.foo.issue_key
+  username  = %s
+}
+`, username)
+}
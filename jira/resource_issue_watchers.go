@@ -0,0 +1,205 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// resourceIssueWatchers is used to define the complete set of users watching
+// a JIRA issue. Watchers not in the set are removed, including the reporter
+// who is added by JIRA when the issue is created.
+func resourceIssueWatchers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIssueWatchersCreate,
+		ReadContext:   resourceIssueWatchersRead,
+		UpdateContext: resourceIssueWatchersUpdate,
+		DeleteContext: resourceIssueWatchersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Manages all watchers of an issue. Conflicts with jira_issue_watcher for the same issue",
+
+		Schema: map[string]*schema.Schema{
+			"issue_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the users watching the issue, or their account IDs on Jira Cloud",
+			},
+		},
+	}
+}
+
+func issueWatchersEndpoint(issueKey string) string {
+	return fmt.Sprintf("%s/watchers", issueEndpoint(issueKey))
+}
+
+// issueWatcherName identifies a watcher by name, or by account ID on Jira
+// Cloud, which doesn't expose the names of users
+func issueWatcherName(watcher *jira.Watcher) string {
+	if watcher.Name == "" {
+		return watcher.AccountID
+	}
+	return watcher.Name
+}
+
+// findIssueWatcher returns the watcher with the given name or account ID.
+// Like JIRA, names are compared case-insensitively.
+func findIssueWatcher(watchers []*jira.Watcher, username string) *jira.Watcher {
+	for _, watcher := range watchers {
+		if strings.EqualFold(issueWatcherName(watcher), username) {
+			return watcher
+		}
+	}
+	return nil
+}
+
+// getIssueWatchers returns the users watching the issue, sorted by name
+func getIssueWatchers(ctx context.Context, client *jira.Client, issueKey string) ([]*jira.Watcher, error) {
+	watches := new(jira.Watches)
+	if err := request(ctx, client, "GET", issueWatchersEndpoint(issueKey), nil, watches); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(watches.Watchers, func(i, j int) bool {
+		return issueWatcherName(watches.Watchers[i]) < issueWatcherName(watches.Watchers[j])
+	})
+	return watches.Watchers, nil
+}
+
+func addIssueWatcher(ctx context.Context, client *jira.Client, issueKey string, username string) error {
+	return request(ctx, client, "POST", issueWatchersEndpoint(issueKey), username, nil)
+}
+
+func removeIssueWatcher(ctx context.Context, client *jira.Client, issueKey string, watcher *jira.Watcher) error {
+	relativeURL, _ := url.Parse(issueWatchersEndpoint(issueKey))
+	query := relativeURL.Query()
+	if watcher.Name == "" {
+		query.Set("accountId", watcher.AccountID)
+	} else {
+		query.Set("username", watcher.Name)
+	}
+	relativeURL.RawQuery = query.Encode()
+
+	return request(ctx, client, "DELETE", relativeURL.String(), nil, nil)
+}
+
+// setIssueWatchers adds and removes watchers until the issue is watched by
+// exactly the given users
+func setIssueWatchers(ctx context.Context, client *jira.Client, issueKey string, usernames []string) error {
+	current, err := getIssueWatchers(ctx, client, issueKey)
+	if err != nil {
+		return err
+	}
+
+	for _, username := range usernames {
+		if findIssueWatcher(current, username) != nil {
+			continue
+		}
+		if err := addIssueWatcher(ctx, client, issueKey, username); err != nil {
+			return err
+		}
+	}
+
+	for _, watcher := range current {
+		if containsStringFold(usernames, issueWatcherName(watcher)) {
+			continue
+		}
+		if err := removeIssueWatcher(ctx, client, issueKey, watcher); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceIssueWatchersCreate sets the watchers of an issue using the jira api
+func resourceIssueWatchersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+	issueKey := d.Get("issue_key").(string)
+
+	err := setIssueWatchers(ctx, config.jiraClient, issueKey, setToStrings(d.Get("users").(*schema.Set)))
+	if err != nil {
+		return errorDiagnostics(err, "creating jira issue watchers failed", nil)
+	}
+
+	d.SetId(issueKey)
+
+	return resourceIssueWatchersRead(ctx, d, m)
+}
+
+// resourceIssueWatchersRead reads the watchers of an issue using jira api
+func resourceIssueWatchersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	watchers, err := getIssueWatchers(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira issue watchers failed", nil)
+	}
+
+	// Keep the spelling of the configured names
+	configured := setToStrings(d.Get("users").(*schema.Set))
+	users := make([]string, 0, len(watchers))
+	for _, watcher := range watchers {
+		username := issueWatcherName(watcher)
+		for _, c := range configured {
+			if strings.EqualFold(c, username) {
+				username = c
+			}
+		}
+		users = append(users, username)
+	}
+
+	d.Set("issue_key", d.Id())
+	d.Set("users", users)
+
+	return nil
+}
+
+// resourceIssueWatchersUpdate updates the watchers of an issue using the jira api
+func resourceIssueWatchersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := setIssueWatchers(ctx, config.jiraClient, d.Id(), setToStrings(d.Get("users").(*schema.Set)))
+	if err != nil {
+		return errorDiagnostics(err, "updating jira issue watchers failed", nil)
+	}
+
+	return resourceIssueWatchersRead(ctx, d, m)
+}
+
+// resourceIssueWatchersDelete removes the watchers of an issue using the jira api
+func resourceIssueWatchersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := setIssueWatchers(ctx, config.jiraClient, d.Id(), nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira issue watchers failed", nil)
+	}
+
+	return nil
+}
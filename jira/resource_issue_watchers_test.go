@@ -0,0 +1,224 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraIssueWatchers_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_watchers.foo"
+	reporter := fmt.Sprintf("watcher-a-%d", rInt)
+	other := fmt.Sprintf("watcher-b-%d", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueWatchersDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The reporter watches the issue until it is removed
+				Config: testAccJiraIssueWatchersConfig(rInt, "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, other),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				Config: testAccJiraIssueWatchersConfig(rInt, "jira_user.a.name", "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, reporter, other),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// JIRA ignores the case of user names
+				Config: testAccJiraIssueWatchersConfig(rInt, "upper(jira_user.a.name)", "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, reporter, other),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", strings.ToUpper(reporter)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueWatchers_drift(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_issue_watchers.foo"
+	reporter := fmt.Sprintf("watcher-a-%d", rInt)
+	other := fmt.Sprintf("watcher-b-%d", rInt)
+	var issueKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraIssueWatchersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueWatchersConfig(rInt, "jira_user.a.name", "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &issueKey),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := removeIssueWatcher(context.Background(), jiraClient, issueKey, &jira.Watcher{Name: other})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraIssueWatchersConfig(rInt, "jira_user.a.name", "jira_user.b.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchers(resourceName, reporter, other),
+				),
+			},
+			{
+				// Deleting the resource removes all watchers of the issue
+				Config: testAccJiraIssueWatchersBaseConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraIssueWatchedBy(&issueKey),
+				),
+			},
+		},
+	})
+}
+
+func TestRemoveIssueWatcher_accountID(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := jira.NewClient(server.Client(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Jira Cloud identifies watchers only by account ID
+	watcher := &jira.Watcher{AccountID: "5b10ac8d82e05b22cc7d4ef5"}
+	if err := removeIssueWatcher(context.Background(), client, "TEST-1", watcher); err != nil {
+		t.Fatal(err)
+	}
+	if query != "accountId=5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("expected the watcher to be removed by account ID, got query %q", query)
+	}
+}
+
+func testAccCheckJiraIssueWatchersDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_issue_watchers" && rs.Type != "jira_issue_watcher" {
+			continue
+		}
+
+		issueKey := strings.SplitN(rs.Primary.ID, ":", 2)[0]
+		watchers, err := getIssueWatchers(context.Background(), client, issueKey)
+		if errors.Is(err, ResourceNotFoundError) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(watchers) != 0 {
+			return fmt.Errorf("Issue %s is still watched by %s", issueKey, issueWatcherName(watchers[0]))
+		}
+	}
+	return nil
+}
+
+// testAccCheckJiraIssueWatchers checks that the issue of the resource is
+// watched by exactly the given users
+func testAccCheckJiraIssueWatchers(n string, usernames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		issueKey := rs.Primary.Attributes["issue_key"]
+		return testAccCheckJiraIssueWatchedBy(&issueKey, usernames...)(s)
+	}
+}
+
+// testAccCheckJiraIssueWatchedBy checks that the issue is watched by exactly
+// the given users
+func testAccCheckJiraIssueWatchedBy(issueKey *string, usernames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Config).jiraClient
+		watchers, err := getIssueWatchers(context.Background(), client, *issueKey)
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(watchers))
+		for _, watcher := range watchers {
+			names = append(names, issueWatcherName(watcher))
+		}
+		sort.Strings(usernames)
+		if strings.Join(names, ",") != strings.Join(usernames, ",") {
+			return fmt.Errorf("Issue %s is watched by %v, expected %v", *issueKey, names, usernames)
+		}
+		return nil
+	}
+}
+
+// testAccJiraIssueWatchersConfig creates an issue reported by user a, watched
+// by the given users
+func testAccJiraIssueWatchersConfig(rInt int, users ...string) string {
+	return testAccJiraIssueWatchersBaseConfig(rInt) + fmt.Sprintf(`
+resource "jira_issue_watchers" "foo" {
+  issue_key = jira_issue.foo.issue_key
+  users     = [%s]
+}
+`, strings.Join(users, ", "))
+}
+
+func testAccJiraIssueWatchersBaseConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_user" "a" {
+  name  = "watcher-a-%d"
+  email = "a@example.org"
+}
+
+resource "jira_user" "b" {
+  name  = "watcher-b-%d"
+  email = "b@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PW%d"
+  lead                 = jira_user.a.name
+  project_type_key     = "business"
+  project_template_key = "com.atlassian.jira-core-project-templates:jira-core-project-management"
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = "Task"
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+  reporter    = jira_user.a.name
+}
+`, rInt, rInt, rInt, rInt%100000)
+}
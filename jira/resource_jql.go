@@ -1,15 +1,17 @@
 package jira
 
 import (
+	"context"
+
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/pkg/errors"
 )
 
 // resourceComment is used to define a JIRA comment
 func resourceJQL() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceJQLRead,
+		ReadContext: resourceJQLRead,
 
 		Schema: map[string]*schema.Schema{
 			"jql": &schema.Schema{
@@ -25,7 +27,7 @@ func resourceJQL() *schema.Resource {
 	}
 }
 
-func resourceJQLRead(d *schema.ResourceData, m interface{}) error {
+func resourceJQLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	jql := d.Get("jql").(string)
 
@@ -36,9 +38,9 @@ func resourceJQLRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 
-	err := config.jiraClient.Issue.SearchPages(jql, nil, handler)
+	err := config.jiraClient.Issue.SearchPagesWithContext(ctx, jql, nil, handler)
 	if err != nil {
-		return errors.Wrapf(err, "searching jira issue failed")
+		return errorDiagnostics(err, "searching jira issues failed", nil)
 	}
 
 	d.SetId(jql)
@@ -0,0 +1,283 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// NotificationSchemeEventType identifies the event a notification is sent for
+type NotificationSchemeEventType struct {
+	ID int `json:"id"`
+}
+
+// Notification is a recipient of the notifications sent for an event
+type Notification struct {
+	ID               int    `json:"id,omitempty"`
+	NotificationType string `json:"notificationType"`
+	Parameter        string `json:"parameter,omitempty"`
+}
+
+// NotificationSchemeEvent lists the recipients of the notifications sent for an event
+type NotificationSchemeEvent struct {
+	Event         NotificationSchemeEventType `json:"event"`
+	Notifications []Notification              `json:"notifications"`
+}
+
+// NotificationScheme The struct sent to and returned by the JIRA instance to manage notification schemes
+type NotificationScheme struct {
+	ID                       int                       `json:"id,omitempty"`
+	Name                     string                    `json:"name"`
+	Description              string                    `json:"description"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents,omitempty"`
+}
+
+var notificationTypes = []string{
+	"CurrentAssignee",
+	"Reporter",
+	"CurrentUser",
+	"ProjectLead",
+	"ComponentLead",
+	"AllWatchers",
+	"User",
+	"Group",
+	"ProjectRole",
+	"EmailAddress",
+	"UserCustomField",
+	"GroupCustomField",
+}
+
+// resourceNotificationScheme is used to define a JIRA notification scheme
+func resourceNotificationScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNotificationSchemeCreate,
+		ReadContext:   resourceNotificationSchemeRead,
+		UpdateContext: resourceNotificationSchemeUpdate,
+		DeleteContext: resourceNotificationSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a notification scheme. The ID can be used as notification_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the notification scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the notification scheme",
+			},
+			"notification": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceNotificationHash,
+				Description: "Recipients of the notifications sent for an event",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "ID of the event (for example 1 for Issue Created)",
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Type of the recipient. Needs to be one of CurrentAssignee, Reporter, CurrentUser, ProjectLead, " +
+								"ComponentLead, AllWatchers, User, Group, ProjectRole, EmailAddress, UserCustomField or GroupCustomField",
+							ValidateFunc: func(v interface{}, s string) ([]string, []error) {
+								if !containsString(notificationTypes, v.(string)) {
+									return nil, []error{fmt.Errorf("type needs to be one of CurrentAssignee, Reporter, CurrentUser, ProjectLead, " +
+										"ComponentLead, AllWatchers, User, Group, ProjectRole, EmailAddress, UserCustomField or GroupCustomField")}
+								}
+								return nil, nil
+							},
+						},
+						"parameter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "User key (account ID on JIRA Cloud), group name, project role ID, email address " +
+								"or custom field ID of the recipient, depending on the type",
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNotificationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["event_id"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+
+	for _, attribute := range []string{"type", "parameter"} {
+		if v, ok := m[attribute]; ok {
+			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+		}
+	}
+
+	return HashString(buf.String())
+}
+
+func notificationSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", notificationSchemeAPIEndpoint, id)
+}
+
+// expandNotificationSchemeEvents groups the notifications by event
+func expandNotificationSchemeEvents(notifications []interface{}) []NotificationSchemeEvent {
+	var events []NotificationSchemeEvent
+	index := make(map[int]int)
+
+	for _, v := range notifications {
+		m := v.(map[string]interface{})
+		eventID := m["event_id"].(int)
+
+		i, ok := index[eventID]
+		if !ok {
+			i = len(events)
+			index[eventID] = i
+			events = append(events, NotificationSchemeEvent{Event: NotificationSchemeEventType{ID: eventID}})
+		}
+
+		events[i].Notifications = append(events[i].Notifications, Notification{
+			NotificationType: m["type"].(string),
+			Parameter:        m["parameter"].(string),
+		})
+	}
+	return events
+}
+
+// resourceNotificationSchemeCreate creates a new jira notification scheme using the jira api
+func resourceNotificationSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &NotificationScheme{
+		Name:                     d.Get("name").(string),
+		Description:              d.Get("description").(string),
+		NotificationSchemeEvents: expandNotificationSchemeEvents(d.Get("notification").(*schema.Set).List()),
+	}
+
+	returnedScheme := new(NotificationScheme)
+	err := request(ctx, config.jiraClient, "POST", notificationSchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira notification scheme failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedScheme.ID))
+
+	return resourceNotificationSchemeRead(ctx, d, m)
+}
+
+// resourceNotificationSchemeRead reads notification scheme details using jira api
+func resourceNotificationSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := new(NotificationScheme)
+	urlStr := fmt.Sprintf("%s?expand=all", notificationSchemeEndpoint(d.Id()))
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira notification scheme failed", nil)
+	}
+
+	// The set is ordered by its hash, independent of the order JIRA returns
+	notifications := &schema.Set{
+		F: resourceNotificationHash,
+	}
+	for _, event := range scheme.NotificationSchemeEvents {
+		for _, notification := range event.Notifications {
+			notifications.Add(map[string]interface{}{
+				"event_id":  event.Event.ID,
+				"type":      notification.NotificationType,
+				"parameter": notification.Parameter,
+				"id":        strconv.Itoa(notification.ID),
+			})
+		}
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("notification", notifications)
+
+	return nil
+}
+
+// resourceNotificationSchemeUpdate updates jira notification scheme using jira api
+func resourceNotificationSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if d.HasChanges("name", "description") {
+		scheme := &NotificationScheme{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", notificationSchemeEndpoint(d.Id()), scheme, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira notification scheme failed", nil)
+		}
+	}
+
+	if d.HasChange("notification") {
+		o, n := d.GetChange("notification")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, notification := range os.Difference(ns).List() {
+			urlStr := fmt.Sprintf("%s/notification/%s", notificationSchemeEndpoint(d.Id()), notification.(map[string]interface{})["id"])
+			err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+			if err != nil && !errors.Is(err, ResourceNotFoundError) {
+				return errorDiagnostics(err, "removing jira notification failed", nil)
+			}
+		}
+
+		if added := ns.Difference(os).List(); len(added) > 0 {
+			body := map[string]interface{}{
+				"notificationSchemeEvents": expandNotificationSchemeEvents(added),
+			}
+			urlStr := fmt.Sprintf("%s/notification", notificationSchemeEndpoint(d.Id()))
+			err := request(ctx, config.jiraClient, "PUT", urlStr, body, nil)
+			if err != nil {
+				return errorDiagnostics(err, "adding jira notifications failed", nil)
+			}
+		}
+	}
+
+	return resourceNotificationSchemeRead(ctx, d, m)
+}
+
+// resourceNotificationSchemeDelete deletes jira notification scheme using the jira api
+func resourceNotificationSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", notificationSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira notification scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraNotificationScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_notification_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraNotificationSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraNotificationSchemeConfig(rInt, "foo", `
+  notification {
+    event_id  = 1
+    type      = "Group"
+    parameter = jira_group.foo.name
+  }
+
+  notification {
+    event_id  = 1
+    type      = "ProjectRole"
+    parameter = jira_role.foo.id
+  }
+
+  notification {
+    event_id = 2
+    type     = "CurrentAssignee"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraNotificationSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "3"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "notification_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraNotificationSchemeConfig(rInt, "bar", `
+  notification {
+    event_id  = 1
+    type      = "Group"
+    parameter = jira_group.foo.name
+  }
+
+  notification {
+    event_id = 2
+    type     = "Reporter"
+  }
+
+  notification {
+    event_id = 2
+    type     = "AllWatchers"
+  }
+
+  notification {
+    event_id = 3
+    type     = "CurrentAssignee"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraNotificationSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraNotificationScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_notification_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraNotificationSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraNotificationSchemeBaseConfig(rInt, "foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", notificationSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraNotificationSchemeBaseConfig(rInt, "foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraNotificationSchemeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraNotificationSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_notification_scheme" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", notificationSchemeEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Notification scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraNotificationSchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No notification scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", notificationSchemeEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Notification scheme %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraNotificationSchemeBaseConfig(rInt int, name string, notifications string) string {
+	return fmt.Sprintf(`
+resource "jira_group" "foo" {
+  name = "foo-group-%d"
+}
+
+resource "jira_role" "foo" {
+  name = "foo-role-%d"
+}
+
+resource "jira_notification_scheme" "foo" {
+  name        = "%s-scheme-%d"
+  description = "Created by Terraform"
+%s
+}
+`, rInt, rInt, name, rInt, notifications)
+}
+
+func testAccJiraNotificationSchemeConfig(rInt int, name string, notifications string) string {
+	return testAccJiraNotificationSchemeBaseConfig(rInt, name, notifications) + fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  notification_scheme  = jira_notification_scheme.foo.id
+}
+`, rInt, rInt, rInt%100000)
+}
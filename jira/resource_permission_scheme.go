@@ -0,0 +1,251 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// PermissionHolder is who is granted a permission
+type PermissionHolder struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// PermissionGrant grants a permission to a holder
+type PermissionGrant struct {
+	ID         int              `json:"id,omitempty"`
+	Holder     PermissionHolder `json:"holder"`
+	Permission string           `json:"permission"`
+}
+
+// PermissionScheme The struct sent to and returned by the JIRA instance to manage permission schemes
+type PermissionScheme struct {
+	ID          int               `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Permissions []PermissionGrant `json:"permissions,omitempty"`
+}
+
+var permissionHolderTypes = []string{
+	"group",
+	"projectRole",
+	"user",
+	"reporter",
+	"assignee",
+	"projectLead",
+	"applicationRole",
+}
+
+// resourcePermissionScheme is used to define a JIRA permission scheme
+func resourcePermissionScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePermissionSchemeCreate,
+		ReadContext:   resourcePermissionSchemeRead,
+		UpdateContext: resourcePermissionSchemeUpdate,
+		DeleteContext: resourcePermissionSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a permission scheme. The ID can be used as permission_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the permission scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the permission scheme",
+			},
+			"grant": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourcePermissionGrantHash,
+				Description: "Permissions granted by the scheme",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permission": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the permission (for example BROWSE_PROJECTS)",
+						},
+						"holder_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Type of the holder. Needs to be one of group, projectRole, user, " +
+								"reporter, assignee, projectLead or applicationRole",
+							ValidateFunc: func(v interface{}, s string) ([]string, []error) {
+								if !containsString(permissionHolderTypes, v.(string)) {
+									return nil, []error{fmt.Errorf("holder_type needs to be one of group, projectRole, user, reporter, assignee, projectLead or applicationRole")}
+								}
+								return nil, nil
+							},
+						},
+						"holder_parameter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Group name, project role ID, user key (account ID on JIRA Cloud) or application key " +
+								"of the holder. Must be empty for reporter, assignee and projectLead",
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePermissionGrantHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	for _, attribute := range []string{"permission", "holder_type", "holder_parameter"} {
+		if v, ok := m[attribute]; ok {
+			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+		}
+	}
+
+	return HashString(buf.String())
+}
+
+func permissionSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", permissionSchemeAPIEndpoint, id)
+}
+
+func expandPermissionGrant(v interface{}) PermissionGrant {
+	m := v.(map[string]interface{})
+	return PermissionGrant{
+		Holder: PermissionHolder{
+			Type:      m["holder_type"].(string),
+			Parameter: m["holder_parameter"].(string),
+		},
+		Permission: m["permission"].(string),
+	}
+}
+
+// resourcePermissionSchemeCreate creates a new jira permission scheme using the jira api
+func resourcePermissionSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &PermissionScheme{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	for _, grant := range d.Get("grant").(*schema.Set).List() {
+		scheme.Permissions = append(scheme.Permissions, expandPermissionGrant(grant))
+	}
+
+	returnedScheme := new(PermissionScheme)
+	err := request(ctx, config.jiraClient, "POST", permissionSchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira permission scheme failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedScheme.ID))
+
+	return resourcePermissionSchemeRead(ctx, d, m)
+}
+
+// resourcePermissionSchemeRead reads permission scheme details using jira api
+func resourcePermissionSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := new(PermissionScheme)
+	urlStr := fmt.Sprintf("%s?expand=permissions", permissionSchemeEndpoint(d.Id()))
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira permission scheme failed", nil)
+	}
+
+	grants := &schema.Set{
+		F: resourcePermissionGrantHash,
+	}
+	for _, grant := range scheme.Permissions {
+		grants.Add(map[string]interface{}{
+			"permission":       grant.Permission,
+			"holder_type":      grant.Holder.Type,
+			"holder_parameter": grant.Holder.Parameter,
+			"id":               strconv.Itoa(grant.ID),
+		})
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("grant", grants)
+
+	return nil
+}
+
+// resourcePermissionSchemeUpdate updates jira permission scheme using jira api
+func resourcePermissionSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if d.HasChanges("name", "description") {
+		scheme := &PermissionScheme{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", permissionSchemeEndpoint(d.Id()), scheme, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira permission scheme failed", nil)
+		}
+	}
+
+	if d.HasChange("grant") {
+		o, n := d.GetChange("grant")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		for _, grant := range os.Difference(ns).List() {
+			urlStr := fmt.Sprintf("%s/permission/%s", permissionSchemeEndpoint(d.Id()), grant.(map[string]interface{})["id"])
+			err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+			if err != nil && !errors.Is(err, ResourceNotFoundError) {
+				return errorDiagnostics(err, "revoking jira permission grant failed", nil)
+			}
+		}
+
+		for _, grant := range ns.Difference(os).List() {
+			urlStr := fmt.Sprintf("%s/permission", permissionSchemeEndpoint(d.Id()))
+			err := request(ctx, config.jiraClient, "POST", urlStr, expandPermissionGrant(grant), nil)
+			if err != nil {
+				return errorDiagnostics(err, "granting jira permission failed", nil)
+			}
+		}
+	}
+
+	return resourcePermissionSchemeRead(ctx, d, m)
+}
+
+// resourcePermissionSchemeDelete deletes jira permission scheme using the jira api
+func resourcePermissionSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", permissionSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira permission scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraPermissionScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_permission_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraPermissionSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPermissionSchemeConfig(rInt, "foo", `
+  grant {
+    permission       = "BROWSE_PROJECTS"
+    holder_type      = "group"
+    holder_parameter = jira_group.foo.name
+  }
+
+  grant {
+    permission       = "EDIT_ISSUES"
+    holder_type      = "projectRole"
+    holder_parameter = jira_role.foo.id
+  }
+
+  grant {
+    permission  = "EDIT_ISSUES"
+    holder_type = "reporter"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPermissionSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "3"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "permission_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraPermissionSchemeConfig(rInt, "bar", `
+  grant {
+    permission       = "BROWSE_PROJECTS"
+    holder_type      = "group"
+    holder_parameter = jira_group.foo.name
+  }
+
+  grant {
+    permission  = "ADMINISTER_PROJECTS"
+    holder_type = "projectLead"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPermissionSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraPermissionScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_permission_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraPermissionSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPermissionSchemeBaseConfig(rInt, "foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", permissionSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraPermissionSchemeBaseConfig(rInt, "foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPermissionSchemeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraPermissionSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_permission_scheme" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", permissionSchemeEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Permission scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraPermissionSchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No permission scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", permissionSchemeEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Permission scheme %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraPermissionSchemeBaseConfig(rInt int, name string, grants string) string {
+	return fmt.Sprintf(`
+resource "jira_group" "foo" {
+  name = "foo-group-%d"
+}
+
+resource "jira_role" "foo" {
+  name = "foo-role-%d"
+}
+
+resource "jira_permission_scheme" "foo" {
+  name        = "%s-scheme-%d"
+  description = "Created by Terraform"
+%s
+}
+`, rInt, rInt, name, rInt, grants)
+}
+
+func testAccJiraPermissionSchemeConfig(rInt int, name string, grants string) string {
+	return testAccJiraPermissionSchemeBaseConfig(rInt, name, grants) + fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  permission_scheme    = jira_permission_scheme.foo.id
+}
+`, rInt, rInt, rInt%100000)
+}
@@ -0,0 +1,141 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// PriorityRequest The struct sent to the JIRA instance to create or update a priority
+type PriorityRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IconURL     string `json:"iconUrl,omitempty"`
+	StatusColor string `json:"statusColor"`
+}
+
+// resourcePriority is used to define a JIRA priority
+func resourcePriority() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePriorityCreate,
+		ReadContext:   resourcePriorityRead,
+		UpdateContext: resourcePriorityUpdate,
+		DeleteContext: resourcePriorityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a priority of issues. When the priority is deleted, JIRA moves its issues to the default priority",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the priority",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the priority",
+			},
+			"color": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{3}([0-9a-fA-F]{3})?$`), "must be a hex color like #ff0000"),
+				Description:  "Color of the priority in hex notation, e.g. #ff0000",
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "URL of the icon of the priority. JIRA chooses an icon if not set",
+			},
+		},
+	}
+}
+
+func priorityEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", priorityAPIEndpoint, id)
+}
+
+func expandPriorityRequest(d *schema.ResourceData) *PriorityRequest {
+	return &PriorityRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IconURL:     d.Get("icon_url").(string),
+		StatusColor: d.Get("color").(string),
+	}
+}
+
+// resourcePriorityCreate creates a new jira priority using the jira api
+func resourcePriorityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	returnedPriority := new(jira.Priority)
+	err := request(ctx, config.jiraClient, "POST", priorityAPIEndpoint, expandPriorityRequest(d), returnedPriority)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira priority failed", nil)
+	}
+
+	d.SetId(returnedPriority.ID)
+
+	return resourcePriorityRead(ctx, d, m)
+}
+
+// resourcePriorityRead reads priority details using jira api
+func resourcePriorityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	priority := new(jira.Priority)
+	err := request(ctx, config.jiraClient, "GET", priorityEndpoint(d.Id()), nil, priority)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira priority failed", nil)
+	}
+
+	d.Set("name", priority.Name)
+	d.Set("description", priority.Description)
+	d.Set("color", priority.StatusColor)
+	d.Set("icon_url", priority.IconURL)
+
+	return nil
+}
+
+// resourcePriorityUpdate updates jira priority using jira api
+func resourcePriorityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "PUT", priorityEndpoint(d.Id()), expandPriorityRequest(d), nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira priority failed", nil)
+	}
+
+	return resourcePriorityRead(ctx, d, m)
+}
+
+// resourcePriorityDelete deletes jira priority using the jira api
+func resourcePriorityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := requestTask(ctx, config.jiraClient, "DELETE", priorityEndpoint(d.Id()), nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira priority failed", nil)
+	}
+
+	return nil
+}
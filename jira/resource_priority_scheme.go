@@ -0,0 +1,164 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// PriorityScheme The struct sent to and returned by the JIRA instance to manage priority schemes
+type PriorityScheme struct {
+	ID              int      `json:"id,omitempty"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	DefaultOptionID string   `json:"defaultOptionId,omitempty"`
+	OptionIDs       []string `json:"optionIds"`
+}
+
+// resourcePriorityScheme is used to define a JIRA priority scheme
+func resourcePriorityScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrioritySchemeCreate,
+		ReadContext:   resourcePrioritySchemeRead,
+		UpdateContext: resourcePrioritySchemeUpdate,
+		DeleteContext: resourcePrioritySchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a priority scheme, which defines the priorities available in projects. " +
+			"The ID can be used as priority_scheme of jira_project. Priority schemes are only supported by JIRA Server and Data Center",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the priority scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the priority scheme",
+			},
+			"priority_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the priorities of the scheme, in the order they are shown",
+			},
+			"default_priority_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the priority new issues get by default. Must be one of priority_ids",
+			},
+		},
+	}
+}
+
+func prioritySchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", prioritySchemeAPIEndpoint, id)
+}
+
+func expandPriorityScheme(d *schema.ResourceData) *PriorityScheme {
+	return &PriorityScheme{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		DefaultOptionID: d.Get("default_priority_id").(string),
+		OptionIDs:       expandStringList(d.Get("priority_ids").([]interface{})),
+	}
+}
+
+// getProjectPriorityScheme returns the priority scheme of the project, or nil
+// if JIRA doesn't report it
+func getProjectPriorityScheme(ctx context.Context, client *jira.Client, projectID string) (*PriorityScheme, error) {
+	scheme := new(PriorityScheme)
+	err := request(ctx, client, "GET", fmt.Sprintf("%s/%s/priorityscheme", projectAPIEndpoint, projectID), nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// assignProjectPriorityScheme makes the priorities of the scheme available in
+// the project
+func assignProjectPriorityScheme(ctx context.Context, client *jira.Client, projectID string, schemeID int) error {
+	body := map[string]int{"id": schemeID}
+	return request(ctx, client, "PUT", fmt.Sprintf("%s/%s/priorityscheme", projectAPIEndpoint, projectID), body, nil)
+}
+
+// resourcePrioritySchemeCreate creates a new jira priority scheme using the jira api
+func resourcePrioritySchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	returnedScheme := new(PriorityScheme)
+	err := request(ctx, config.jiraClient, "POST", prioritySchemeAPIEndpoint, expandPriorityScheme(d), returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira priority scheme failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedScheme.ID))
+
+	return resourcePrioritySchemeRead(ctx, d, m)
+}
+
+// resourcePrioritySchemeRead reads priority scheme details using jira api
+func resourcePrioritySchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := new(PriorityScheme)
+	err := request(ctx, config.jiraClient, "GET", prioritySchemeEndpoint(d.Id()), nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira priority scheme failed", nil)
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("priority_ids", scheme.OptionIDs)
+	d.Set("default_priority_id", scheme.DefaultOptionID)
+
+	return nil
+}
+
+// resourcePrioritySchemeUpdate updates jira priority scheme using jira api
+func resourcePrioritySchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "PUT", prioritySchemeEndpoint(d.Id()), expandPriorityScheme(d), nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira priority scheme failed", nil)
+	}
+
+	return resourcePrioritySchemeRead(ctx, d, m)
+}
+
+// resourcePrioritySchemeDelete deletes jira priority scheme using the jira api
+func resourcePrioritySchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", prioritySchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira priority scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraPriorityScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_priority_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraPrioritySchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPrioritySchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPrioritySchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "priority_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "priority_ids.0", "jira_priority.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_priority_id", "jira_priority.foo", "id"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "priority_scheme", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccJiraPrioritySchemeConfig(rInt, "bar", "bar", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPrioritySchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "priority_ids.0", "jira_priority.bar", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "priority_ids.1", "jira_priority.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_priority_id", "jira_priority.bar", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraPriorityScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_priority_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraPrioritySchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPrioritySchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", prioritySchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraPrioritySchemeConfig(rInt, "foo", "foo", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPrioritySchemeExists(resourceName),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "priority_scheme", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraPrioritySchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_priority_scheme" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", prioritySchemeEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Priority scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraPrioritySchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No priority scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", prioritySchemeEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Priority scheme %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraPrioritySchemeConfig(rInt int, name string, first string, second string) string {
+	return fmt.Sprintf(`
+resource "jira_priority" "foo" {
+  name  = "foo-priority-%d"
+  color = "#ff0000"
+}
+
+resource "jira_priority" "bar" {
+  name  = "bar-priority-%d"
+  color = "#00ff00"
+}
+
+resource "jira_priority_scheme" "foo" {
+  name                = "%s-scheme-%d"
+  description         = "Created by Terraform"
+  priority_ids        = [jira_priority.%s.id, jira_priority.%s.id]
+  default_priority_id = jira_priority.%s.id
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  priority_scheme      = jira_priority_scheme.foo.id
+}
+`, rInt, rInt, name, rInt, first, second, first, rInt, rInt, rInt%100000)
+}
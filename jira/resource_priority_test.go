@@ -0,0 +1,126 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraPriority_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_priority.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraPriorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPriorityConfig(rInt, "foo", "#ff0000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPriorityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-priority-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "color", "#ff0000"),
+					resource.TestCheckResourceAttrSet(resourceName, "icon_url"),
+				),
+			},
+			{
+				Config: testAccJiraPriorityConfig(rInt, "bar", "#00ff00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPriorityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-priority-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "color", "#00ff00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraPriority_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_priority.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraPriorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraPriorityConfig(rInt, "foo", "#ff0000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := requestTask(context.Background(), jiraClient, "DELETE", priorityEndpoint(id), nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraPriorityConfig(rInt, "foo", "#ff0000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraPriorityExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraPriorityDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_priority" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", priorityEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Priority %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraPriorityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No priority ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", priorityEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Priority %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraPriorityConfig(rInt int, name string, color string) string {
+	return fmt.Sprintf(`
+resource "jira_priority" "foo" {
+  name        = "%s-priority-%d"
+  description = "Created by Terraform"
+  color       = "%s"
+}
+`, name, rInt, color)
+}
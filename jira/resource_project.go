@@ -1,10 +1,14 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -59,8 +63,8 @@ type IDResponse struct {
 }
 
 // GetJiraResourceID Fetches the ID of a JIRA resource
-func GetJiraResourceID(client *jira.Client, urlStr string) (*int, error) {
-	req, err := client.NewRequest("GET", urlStr, nil)
+func GetJiraResourceID(ctx context.Context, client *jira.Client, urlStr string) (*int, error) {
+	req, err := client.NewRequestWithContext(ctx, "GET", urlStr, nil)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "Creating Request failed")
@@ -70,23 +74,44 @@ func GetJiraResourceID(client *jira.Client, urlStr string) (*int, error) {
 
 	resp, err := client.Do(req, response)
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if resp != nil && resp.StatusCode == 404 {
 			return nil, nil
 		}
-		return nil, errors.Wrap(err, "Creating Project Request failed")
+		return nil, newAPIError("GET", urlStr, resp, err)
 	}
 
 	return &response.ID, nil
 }
 
+// projectAttributePath maps the fields rejected by JIRA to the attributes of jira_project
+func projectAttributePath(field string) cty.Path {
+	switch field {
+	case "projectKey":
+		return cty.GetAttrPath("key")
+	case "projectName":
+		return cty.GetAttrPath("name")
+	case "projectLead":
+		return cty.GetAttrPath("lead")
+	case "projectType":
+		return cty.GetAttrPath("project_type_key")
+	}
+	return nil
+}
+
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProjectCreate,
-		Read:   resourceProjectRead,
-		Update: resourceProjectUpdate,
-		Delete: resourceProjectDelete,
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -154,6 +179,38 @@ func resourceProject() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"issue_type_scheme": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the issue type scheme, which defines the issue types available in the project",
+			},
+			"issue_type_screen_scheme": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the issue type screen scheme, which defines the screens used for the issues of the project",
+			},
+			"field_configuration_scheme": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the field configuration scheme, which defines the required and hidden fields of the issues of the project",
+			},
+			"priority_scheme": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the priority scheme, which defines the priorities available in the project. Only supported by JIRA Server and Data Center",
+			},
+			"workflow_scheme": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the workflow scheme. Changing it migrates the issues to the workflows of the new scheme",
+			},
+			"workflow_status_mapping": workflowStatusMappingSchema("Statuses the issues are moved to when the workflow scheme is changed, " +
+				"if the new workflow of their issue type doesn't contain their current status"),
 			"category_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -167,7 +224,7 @@ func resourceProject() *schema.Resource {
 }
 
 // resourceProjectCreate creates a new jira issue using the jira api
-func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	// Acquire lock to avoid race conditions within JIRA while the project is being created
@@ -187,18 +244,16 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 
 		endpoint := projectWithSharedConfigurationAPIEndpoint(sharedProjectID.(int))
 
-		err := request(config.jiraClient, "POST", endpoint, project, returnedProject)
+		err := request(ctx, config.jiraClient, "POST", endpoint, project, returnedProject)
 
 		if err != nil {
-			return errors.Wrap(err, "Request failed")
+			return errorDiagnostics(err, "creating jira project failed", projectAttributePath)
 		}
 
 		d.SetId(strconv.Itoa(returnedProject.ProjectID))
 
-		err = resourceProjectUpdate(d, m)
-
-		if err != nil {
-			return errors.Wrap(err, "Request failed")
+		if diags := resourceProjectUpdate(ctx, d, m); diags.HasError() {
+			return diags
 		}
 
 	} else {
@@ -221,38 +276,60 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 
 		returnedProject := new(IDResponse)
 
-		err := request(config.jiraClient, "POST", projectAPIEndpoint, project, returnedProject)
+		err := request(ctx, config.jiraClient, "POST", projectAPIEndpoint, project, returnedProject)
 		if err != nil {
-			return errors.Wrap(err, "Request failed")
+			return errorDiagnostics(err, "creating jira project failed", projectAttributePath)
 		}
 
 		d.SetId(strconv.Itoa(returnedProject.ID))
+
+		if diags := resourceProjectAssignIssueTypeScheme(ctx, d, config); diags.HasError() {
+			return diags
+		}
+
+		if diags := resourceProjectAssignIssueTypeScreenScheme(ctx, d, config); diags.HasError() {
+			return diags
+		}
+
+		if diags := resourceProjectAssignFieldConfigurationScheme(ctx, d, config); diags.HasError() {
+			return diags
+		}
+
+		if diags := resourceProjectAssignPriorityScheme(ctx, d, config); diags.HasError() {
+			return diags
+		}
+
+		if diags := resourceProjectSwitchWorkflowScheme(ctx, d, config); diags.HasError() {
+			return diags
+		}
 	}
 
-	return resourceProjectRead(d, m)
+	return resourceProjectRead(ctx, d, m)
 
 }
 
 // resourceProjectRead reads issue details using jira api
-func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	project := &Project{}
 
 	urlStr := fmt.Sprintf("%s/%s", projectAPIEndpoint, d.Id())
-	err := request(config.jiraClient, "GET", urlStr, nil, project)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, project)
 
 	if err != nil {
 		if errors.Is(err, ResourceNotFoundError) {
 			d.SetId("")
 			return nil
 		}
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "reading jira project failed", nil)
 	}
 
-	if err != nil {
-		return errors.Wrap(err, "getting jira project failed")
-	}
-	id, _ := strconv.Atoi(d.Id())
+	return setProjectResource(ctx, config.jiraClient, project, d)
+}
+
+// setProjectResource sets the attributes shared by the jira_project resource and data source
+func setProjectResource(ctx context.Context, client *jira.Client, project *Project, d *schema.ResourceData) diag.Diagnostics {
+	id, _ := strconv.Atoi(project.ID)
 	d.Set("project_id", id)
 	d.Set("key", project.Key)
 	d.Set("name", project.Name)
@@ -266,30 +343,75 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("archived", project.Archived)
 
 	if !project.Archived {
-		issuesecuritylevelscheme, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/issuesecuritylevelscheme", projectAPIEndpoint, d.Id()))
+		issuesecuritylevelscheme, err := GetJiraResourceID(ctx, client, fmt.Sprintf("%s/%s/issuesecuritylevelscheme", projectAPIEndpoint, project.ID))
 		if err != nil {
-			return errors.Wrap(err, "getting issuesecuritylevelscheme failed")
+			return errorDiagnostics(err, "getting issuesecuritylevelscheme failed", nil)
 		}
 		d.Set("issue_security_scheme", issuesecuritylevelscheme)
 
-		notificationscheme, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/notificationscheme", projectAPIEndpoint, d.Id()))
+		notificationscheme, err := GetJiraResourceID(ctx, client, fmt.Sprintf("%s/%s/notificationscheme", projectAPIEndpoint, project.ID))
 		if err != nil {
-			return errors.Wrap(err, "getting notificationscheme failed")
+			return errorDiagnostics(err, "getting notificationscheme failed", nil)
 		}
 		d.Set("notification_scheme", notificationscheme)
 
-		permissionscheme, err := GetJiraResourceID(config.jiraClient, fmt.Sprintf("%s/%s/permissionscheme", projectAPIEndpoint, d.Id()))
+		permissionscheme, err := GetJiraResourceID(ctx, client, fmt.Sprintf("%s/%s/permissionscheme", projectAPIEndpoint, project.ID))
 		if err != nil {
-			return errors.Wrap(err, "getting permissionscheme failed")
+			return errorDiagnostics(err, "getting permissionscheme failed", nil)
 		}
 		d.Set("permission_scheme", permissionscheme)
+
+		issuetypescheme, err := getProjectIssueTypeScheme(ctx, client, project.ID)
+		if err != nil {
+			return errorDiagnostics(err, "getting issuetypescheme failed", nil)
+		}
+		if issuetypescheme != nil {
+			id, _ := strconv.Atoi(issuetypescheme.ID)
+			d.Set("issue_type_scheme", id)
+		}
+
+		issuetypescreenscheme, err := getProjectIssueTypeScreenScheme(ctx, client, project.ID)
+		if err != nil {
+			return errorDiagnostics(err, "getting issuetypescreenscheme failed", nil)
+		}
+		if issuetypescreenscheme != nil {
+			id, _ := strconv.Atoi(issuetypescreenscheme.ID)
+			d.Set("issue_type_screen_scheme", id)
+		}
+
+		fieldconfigurationscheme, err := getProjectFieldConfigurationScheme(ctx, client, project.ID)
+		if err != nil {
+			return errorDiagnostics(err, "getting fieldconfigurationscheme failed", nil)
+		}
+		// Projects using the default field configuration have no scheme
+		fieldConfigurationSchemeID := 0
+		if fieldconfigurationscheme != nil {
+			fieldConfigurationSchemeID, _ = strconv.Atoi(fieldconfigurationscheme.ID)
+		}
+		d.Set("field_configuration_scheme", fieldConfigurationSchemeID)
+
+		priorityscheme, err := getProjectPriorityScheme(ctx, client, project.ID)
+		if err != nil {
+			return errorDiagnostics(err, "getting priorityscheme failed", nil)
+		}
+		if priorityscheme != nil {
+			d.Set("priority_scheme", priorityscheme.ID)
+		}
+
+		workflowscheme, err := getProjectWorkflowScheme(ctx, client, project.ID)
+		if err != nil {
+			return errorDiagnostics(err, "getting workflowscheme failed", nil)
+		}
+		if workflowscheme != nil {
+			d.Set("workflow_scheme", workflowscheme.ID)
+		}
 	}
 
 	return nil
 }
 
 // resourceProjectUpdate updates jira issue using jira api
-func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	project := &ProjectRequest{
@@ -312,31 +434,127 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 
 	returnedProject := new(jira.Project)
 
-	err := request(config.jiraClient, "PUT", urlStr, project, returnedProject)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, project, returnedProject)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira project failed", projectAttributePath)
+	}
+
+	if diags := resourceProjectAssignIssueTypeScheme(ctx, d, config); diags.HasError() {
+		return diags
+	}
+
+	if diags := resourceProjectAssignIssueTypeScreenScheme(ctx, d, config); diags.HasError() {
+		return diags
+	}
+
+	if diags := resourceProjectAssignFieldConfigurationScheme(ctx, d, config); diags.HasError() {
+		return diags
+	}
+
+	if diags := resourceProjectAssignPriorityScheme(ctx, d, config); diags.HasError() {
+		return diags
+	}
+
+	if diags := resourceProjectSwitchWorkflowScheme(ctx, d, config); diags.HasError() {
+		return diags
 	}
 
 	if d.HasChange("project_type_key") {
 		urlStr := fmt.Sprintf("%s/%s/type/%s", projectAPIEndpoint, d.Id(), d.Get("project_type_key"))
-		err := request(config.jiraClient, "PUT", urlStr, nil, nil)
+		err := request(ctx, config.jiraClient, "PUT", urlStr, nil, nil)
 		if err != nil {
-			return errors.Wrap(err, "Request failed")
+			return errorDiagnostics(err, "updating jira project failed", projectAttributePath)
 		}
 	}
 
-	return resourceProjectRead(d, m)
+	return resourceProjectRead(ctx, d, m)
+}
+
+// resourceProjectAssignIssueTypeScheme assigns the configured issue type
+// scheme to the project
+func resourceProjectAssignIssueTypeScheme(ctx context.Context, d *schema.ResourceData, config *Config) diag.Diagnostics {
+	schemeID := d.Get("issue_type_scheme").(int)
+	if !d.HasChange("issue_type_scheme") || schemeID == 0 {
+		return nil
+	}
+
+	err := assignProjectIssueTypeScheme(ctx, config.jiraClient, d.Id(), schemeID)
+	if err != nil {
+		return errorDiagnostics(err, "assigning issue type scheme to jira project failed", nil)
+	}
+	return nil
+}
+
+// resourceProjectAssignIssueTypeScreenScheme assigns the configured issue type
+// screen scheme to the project
+func resourceProjectAssignIssueTypeScreenScheme(ctx context.Context, d *schema.ResourceData, config *Config) diag.Diagnostics {
+	schemeID := d.Get("issue_type_screen_scheme").(int)
+	if !d.HasChange("issue_type_screen_scheme") || schemeID == 0 {
+		return nil
+	}
+
+	err := assignProjectIssueTypeScreenScheme(ctx, config.jiraClient, d.Id(), schemeID)
+	if err != nil {
+		return errorDiagnostics(err, "assigning issue type screen scheme to jira project failed", nil)
+	}
+	return nil
+}
+
+// resourceProjectAssignFieldConfigurationScheme assigns the configured field
+// configuration scheme to the project
+func resourceProjectAssignFieldConfigurationScheme(ctx context.Context, d *schema.ResourceData, config *Config) diag.Diagnostics {
+	schemeID := d.Get("field_configuration_scheme").(int)
+	if !d.HasChange("field_configuration_scheme") || schemeID == 0 {
+		return nil
+	}
+
+	err := assignProjectFieldConfigurationScheme(ctx, config.jiraClient, d.Id(), schemeID)
+	if err != nil {
+		return errorDiagnostics(err, "assigning field configuration scheme to jira project failed", nil)
+	}
+	return nil
+}
+
+// resourceProjectAssignPriorityScheme assigns the configured priority scheme
+// to the project
+func resourceProjectAssignPriorityScheme(ctx context.Context, d *schema.ResourceData, config *Config) diag.Diagnostics {
+	schemeID := d.Get("priority_scheme").(int)
+	if !d.HasChange("priority_scheme") || schemeID == 0 {
+		return nil
+	}
+
+	err := assignProjectPriorityScheme(ctx, config.jiraClient, d.Id(), schemeID)
+	if err != nil {
+		return errorDiagnostics(err, "assigning priority scheme to jira project failed", nil)
+	}
+	return nil
+}
+
+// resourceProjectSwitchWorkflowScheme assigns the configured workflow scheme
+// to the project
+func resourceProjectSwitchWorkflowScheme(ctx context.Context, d *schema.ResourceData, config *Config) diag.Diagnostics {
+	schemeID := d.Get("workflow_scheme").(int)
+	if !d.HasChange("workflow_scheme") || schemeID == 0 {
+		return nil
+	}
+
+	mappings := expandWorkflowStatusMappings(d.Get("workflow_status_mapping"))
+	err := switchProjectWorkflowScheme(ctx, config.jiraClient, d.Id(), schemeID, mappings)
+	if err != nil {
+		return errorDiagnostics(err, "switching workflow scheme of jira project failed", nil)
+	}
+	return nil
 }
 
 // resourceProjectDelete deletes jira issue using the jira api
-func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", projectAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira project failed", nil)
 	}
 
 	return nil
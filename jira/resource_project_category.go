@@ -1,8 +1,10 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -17,12 +19,18 @@ type ProjectCategory struct {
 
 func resourceProjectCategory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProjectCategoryCreate,
-		Read:   resourceProjectCategoryRead,
-		Update: resourceProjectCategoryUpdate,
-		Delete: resourceProjectCategoryDelete,
+		CreateContext: resourceProjectCategoryCreate,
+		ReadContext:   resourceProjectCategoryRead,
+		UpdateContext: resourceProjectCategoryUpdate,
+		DeleteContext: resourceProjectCategoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +58,7 @@ func setProjectCategoryResource(w *ProjectCategory, d *schema.ResourceData) {
 }
 
 // resourceProjectCategoryCreate creates a new jira issue using the jira api
-func resourceProjectCategoryCreate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectCategoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	projectCategory := new(ProjectCategory)
@@ -58,27 +66,31 @@ func resourceProjectCategoryCreate(d *schema.ResourceData, m interface{}) error
 
 	setProjectCategory(projectCategory, d)
 
-	err := request(config.jiraClient, "POST", projectCategoryAPIEndpoint, projectCategory, returnedProjectCategory)
+	err := request(ctx, config.jiraClient, "POST", projectCategoryAPIEndpoint, projectCategory, returnedProjectCategory)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira project category failed", nil)
 	}
 
 	setProjectCategoryResource(returnedProjectCategory, d)
 
-	return resourceProjectCategoryRead(d, m)
+	return resourceProjectCategoryRead(ctx, d, m)
 }
 
 // resourceProjectCategoryRead reads issue details using jira api
-func resourceProjectCategoryRead(d *schema.ResourceData, m interface{}) error {
+func resourceProjectCategoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", projectCategoryAPIEndpoint, d.Id())
 
 	projectCategory := new(ProjectCategory)
-	err := request(config.jiraClient, "GET", urlStr, nil, projectCategory)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, projectCategory)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira project category failed", nil)
 	}
 
 	setProjectCategoryResource(projectCategory, d)
@@ -87,7 +99,7 @@ func resourceProjectCategoryRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceProjectCategoryUpdate updates jira issue using jira api
-func resourceProjectCategoryUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectCategoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	projectCategory := new(ProjectCategory)
@@ -97,25 +109,25 @@ func resourceProjectCategoryUpdate(d *schema.ResourceData, m interface{}) error
 	urlStr := fmt.Sprintf("%s/%s", projectCategoryAPIEndpoint, d.Id())
 	returnedProjectCategory := new(ProjectCategory)
 
-	err := request(config.jiraClient, "PUT", urlStr, projectCategory, returnedProjectCategory)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, projectCategory, returnedProjectCategory)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira project category failed", nil)
 	}
 
-	return resourceProjectCategoryRead(d, m)
+	return resourceProjectCategoryRead(ctx, d, m)
 }
 
 // resourceProjectCategoryDelete deletes jira issue using the jira api
-func resourceProjectCategoryDelete(d *schema.ResourceData, m interface{}) error {
+func resourceProjectCategoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", projectCategoryAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira project category failed", nil)
 	}
 
 	return nil
@@ -1,10 +1,12 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -33,11 +35,16 @@ type ProjectRole struct {
 
 func resourceProjectMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceProjectMembershipCreate,
-		Read:   resourceProjectMembershipRead,
-		Delete: resourceProjectMembershipDelete,
+		CreateContext: resourceProjectMembershipCreate,
+		ReadContext:   resourceProjectMembershipRead,
+		DeleteContext: resourceProjectMembershipDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,7 +97,7 @@ func setProjectMembership(w *ProjectMembershipRequest, d *schema.ResourceData) e
 }
 
 // resourceProjectMembershipCreate creates a new jira issue using the jira api
-func resourceProjectMembershipCreate(d *schema.ResourceData, m interface{}) error {
+func resourceProjectMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 	projectKey := d.Get("project_key").(string)
 	roleID := d.Get("role_id").(int)
@@ -100,23 +107,23 @@ func resourceProjectMembershipCreate(d *schema.ResourceData, m interface{}) erro
 
 	err := setProjectMembership(role, d)
 	if err != nil {
-		return err
+		return errorDiagnostics(err, "creating jira project membership failed", nil)
 	}
 
 	urlStr := fmt.Sprintf("%s/%d", projectRoleAPIEndpoint(projectKey), roleID)
 
-	err = request(config.jiraClient, "POST", urlStr, role, returnedRole)
+	err = request(ctx, config.jiraClient, "POST", urlStr, role, returnedRole)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira project membership failed", nil)
 	}
 
 	d.SetId(strconv.Itoa(returnedRole.Actors[0].ID))
 
-	return resourceProjectMembershipRead(d, m)
+	return resourceProjectMembershipRead(ctx, d, m)
 }
 
 // resourceProjectMembershipRead
-func resourceProjectMembershipRead(d *schema.ResourceData, m interface{}) error {
+func resourceProjectMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	projectKey := d.Get("project_key").(string)
@@ -125,10 +132,14 @@ func resourceProjectMembershipRead(d *schema.ResourceData, m interface{}) error
 	urlStr := fmt.Sprintf("%s/%d", projectRoleAPIEndpoint(projectKey), roleID)
 
 	role := new(ProjectRole)
-	err := request(config.jiraClient, "GET", urlStr, nil, role)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, role)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira project membership failed", nil)
 	}
 
 	for _, actor := range role.Actors {
@@ -145,7 +156,7 @@ func resourceProjectMembershipRead(d *schema.ResourceData, m interface{}) error
 // resourceProjectMembershipUpdate updates jira issue using jira api
 
 // resourceProjectMembershipDelete deletes jira issue using the jira api
-func resourceProjectMembershipDelete(d *schema.ResourceData, m interface{}) error {
+func resourceProjectMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	projectKey := d.Get("project_key").(string)
@@ -162,10 +173,10 @@ func resourceProjectMembershipDelete(d *schema.ResourceData, m interface{}) erro
 		return nil
 	}
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira project membership failed", nil)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -53,7 +54,7 @@ func TestAccJiraProject_deleted(t *testing.T) {
 				PreConfig: func() {
 					urlStr := fmt.Sprintf("%s/PX%d", projectAPIEndpoint, rInt%100000)
 					jiraClient := testAccProvider.Meta().(*Config).jiraClient
-					err := request(jiraClient, "DELETE", urlStr, nil, nil)
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
 					if err != nil {
 						t.Fatal(err)
 					}
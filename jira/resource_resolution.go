@@ -0,0 +1,152 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// ResolutionRequest The struct sent to the JIRA instance to create or update a resolution
+type ResolutionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// resourceResolution is used to define a JIRA resolution
+func resourceResolution() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResolutionCreate,
+		ReadContext:   resourceResolutionRead,
+		UpdateContext: resourceResolutionUpdate,
+		DeleteContext: resourceResolutionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a resolution of issues",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the resolution",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the resolution",
+			},
+			"replace_with_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "ID of the resolution set on the issues of this resolution when it is deleted. " +
+					"Defaults to the first other resolution",
+			},
+		},
+	}
+}
+
+func resolutionEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", resolutionAPIEndpoint, id)
+}
+
+// resourceResolutionCreate creates a new jira resolution using the jira api
+func resourceResolutionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	resolution := &ResolutionRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	returnedResolution := new(jira.Resolution)
+	err := request(ctx, config.jiraClient, "POST", resolutionAPIEndpoint, resolution, returnedResolution)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira resolution failed", nil)
+	}
+
+	d.SetId(returnedResolution.ID)
+
+	return resourceResolutionRead(ctx, d, m)
+}
+
+// resourceResolutionRead reads resolution details using jira api
+func resourceResolutionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	resolution := new(jira.Resolution)
+	err := request(ctx, config.jiraClient, "GET", resolutionEndpoint(d.Id()), nil, resolution)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira resolution failed", nil)
+	}
+
+	d.Set("name", resolution.Name)
+	d.Set("description", resolution.Description)
+
+	return nil
+}
+
+// resourceResolutionUpdate updates jira resolution using jira api
+func resourceResolutionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if d.HasChanges("name", "description") {
+		resolution := &ResolutionRequest{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", resolutionEndpoint(d.Id()), resolution, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira resolution failed", nil)
+		}
+	}
+
+	return resourceResolutionRead(ctx, d, m)
+}
+
+// resourceResolutionDelete deletes jira resolution using the jira api
+func resourceResolutionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	// JIRA needs a resolution for the issues of the deleted one
+	replacement := d.Get("replace_with_id").(string)
+	if replacement == "" {
+		resolutions := []jira.Resolution{}
+		err := request(ctx, config.jiraClient, "GET", resolutionAPIEndpoint, nil, &resolutions)
+		if err != nil {
+			return errorDiagnostics(err, "reading jira resolutions failed", nil)
+		}
+		for _, resolution := range resolutions {
+			if resolution.ID != d.Id() {
+				replacement = resolution.ID
+				break
+			}
+		}
+	}
+
+	query := url.Values{}
+	query.Set("replaceWith", replacement)
+
+	urlStr := fmt.Sprintf("%s?%s", resolutionEndpoint(d.Id()), query.Encode())
+	err := requestTask(ctx, config.jiraClient, "DELETE", urlStr, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira resolution failed", nil)
+	}
+
+	return nil
+}
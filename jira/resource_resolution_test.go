@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraResolution_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_resolution.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraResolutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraResolutionConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraResolutionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-resolution-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by Terraform"),
+				),
+			},
+			{
+				Config: testAccJiraResolutionConfig(rInt, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraResolutionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-resolution-%d", rInt)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraResolution_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_resolution.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraResolutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraResolutionConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					resolutions := []jira.Resolution{}
+					err := request(context.Background(), jiraClient, "GET", resolutionAPIEndpoint, nil, &resolutions)
+					if err != nil {
+						t.Fatal(err)
+					}
+					query := url.Values{}
+					for _, resolution := range resolutions {
+						if resolution.ID != id {
+							query.Set("replaceWith", resolution.ID)
+						}
+					}
+					urlStr := fmt.Sprintf("%s?%s", resolutionEndpoint(id), query.Encode())
+					if err := requestTask(context.Background(), jiraClient, "DELETE", urlStr, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraResolutionConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraResolutionExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraResolutionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_resolution" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", resolutionEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Resolution %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraResolutionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No resolution ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", resolutionEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Resolution %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraResolutionConfig(rInt int, name string) string {
+	return fmt.Sprintf(`
+resource "jira_resolution" "foo" {
+  name        = "%s-resolution-%d"
+  description = "Created by Terraform"
+}
+`, name, rInt)
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -17,12 +19,18 @@ type Role struct {
 
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRoleCreate,
-		Read:   resourceRoleRead,
-		Update: resourceRoleUpdate,
-		Delete: resourceRoleDelete,
+		CreateContext: resourceRoleCreate,
+		ReadContext:   resourceRoleRead,
+		UpdateContext: resourceRoleUpdate,
+		DeleteContext: resourceRoleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +58,7 @@ func setRoleResource(w *Role, d *schema.ResourceData) {
 }
 
 // resourceRoleCreate creates a new jira issue using the jira api
-func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	role := new(Role)
@@ -58,27 +66,31 @@ func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 
 	setRole(role, d)
 
-	err := request(config.jiraClient, "POST", roleAPIEndpoint, role, returnedRole)
+	err := request(ctx, config.jiraClient, "POST", roleAPIEndpoint, role, returnedRole)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira role failed", nil)
 	}
 
 	setRoleResource(returnedRole, d)
 
-	return resourceRoleRead(d, m)
+	return resourceRoleRead(ctx, d, m)
 }
 
 // resourceRoleRead reads issue details using jira api
-func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", roleAPIEndpoint, d.Id())
 
 	role := new(Role)
-	err := request(config.jiraClient, "GET", urlStr, nil, role)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, role)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira role failed", nil)
 	}
 
 	setRoleResource(role, d)
@@ -87,7 +99,7 @@ func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceRoleUpdate updates jira issue using jira api
-func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	role := new(Role)
@@ -97,25 +109,25 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	urlStr := fmt.Sprintf("%s/%s", roleAPIEndpoint, d.Id())
 	returnedRole := new(Role)
 
-	err := request(config.jiraClient, "PUT", urlStr, role, returnedRole)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, role, returnedRole)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira role failed", nil)
 	}
 
-	return resourceRoleRead(d, m)
+	return resourceRoleRead(ctx, d, m)
 }
 
 // resourceRoleDelete deletes jira issue using the jira api
-func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", roleAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira role failed", nil)
 	}
 
 	return nil
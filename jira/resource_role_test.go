@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraRole_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_role.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraRoleConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraRoleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by Terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraRole_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_role.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraRoleConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					urlStr := fmt.Sprintf("%s/%s", roleAPIEndpoint, id)
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraRoleConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraRoleExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraRoleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_role" {
+			continue
+		}
+
+		urlStr := fmt.Sprintf("%s/%s", roleAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No role ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		urlStr := fmt.Sprintf("%s/%s", roleAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if err != nil {
+			return fmt.Errorf("Role %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraRoleConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_role" "foo" {
+  name        = "foo-role-%d"
+  description = "Created by Terraform"
+}`, rInt)
+}
@@ -0,0 +1,356 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// Screen The struct sent to and returned by the JIRA instance to manage screens
+type Screen struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ScreenTab is a tab of a screen
+type ScreenTab struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// ScreenTabField is a field shown on a screen tab
+type ScreenTabField struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// resourceScreen is used to define a JIRA screen
+func resourceScreen() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScreenCreate,
+		ReadContext:   resourceScreenRead,
+		UpdateContext: resourceScreenUpdate,
+		DeleteContext: resourceScreenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a screen, which arranges fields on tabs. The ID can be used in jira_screen_scheme",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the screen",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the screen",
+			},
+			"tab": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Description: "Tabs of the screen, in the order they are shown. Tabs are identified by their names, " +
+					"renaming a tab recreates it",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the tab. Must be unique within the screen",
+						},
+						"fields": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the fields shown on the tab, in the order they are shown. A field can only be on one tab",
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func screenEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", screenAPIEndpoint, id)
+}
+
+// getScreen returns the screen, or nil if it does not exist
+func getScreen(ctx context.Context, client *jira.Client, id string) (*Screen, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	var screen *Screen
+	err := requestPages(ctx, client, screenAPIEndpoint, query, func(value json.RawMessage) error {
+		screen = new(Screen)
+		return json.Unmarshal(value, screen)
+	})
+	return screen, err
+}
+
+func getScreenTabFields(ctx context.Context, client *jira.Client, screenID string, tabID int) ([]string, error) {
+	fields := []ScreenTabField{}
+	urlStr := fmt.Sprintf("%s/tabs/%d/fields", screenEndpoint(screenID), tabID)
+	if err := request(ctx, client, "GET", urlStr, nil, &fields); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(fields))
+	for _, field := range fields {
+		ids = append(ids, field.ID)
+	}
+	return ids, nil
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// syncScreenTabs makes the tabs of the screen and their fields match the
+// configured tabs
+func syncScreenTabs(ctx context.Context, client *jira.Client, screenID string, configured []interface{}) error {
+	endpoint := fmt.Sprintf("%s/tabs", screenEndpoint(screenID))
+
+	existing := []ScreenTab{}
+	if err := request(ctx, client, "GET", endpoint, nil, &existing); err != nil {
+		return err
+	}
+	existingIDs := make(map[string]int)
+	order := []string{}
+	for _, tab := range existing {
+		existingIDs[tab.Name] = tab.ID
+		order = append(order, tab.Name)
+	}
+
+	// Missing tabs are created first, as a screen always needs a tab
+	names := []string{}
+	tabIDs := make(map[string]int)
+	tabFields := make(map[string][]string)
+	for _, v := range configured {
+		m := v.(map[string]interface{})
+		name := m["name"].(string)
+		names = append(names, name)
+		tabFields[name] = expandStringList(m["fields"].([]interface{}))
+
+		if id, ok := existingIDs[name]; ok {
+			tabIDs[name] = id
+			continue
+		}
+		tab := new(ScreenTab)
+		if err := request(ctx, client, "POST", endpoint, &ScreenTab{Name: name}, tab); err != nil {
+			return errors.Wrapf(err, "creating tab %q failed", name)
+		}
+		tabIDs[name] = tab.ID
+		order = append(order, name)
+	}
+
+	// A field can only be on one tab, so fields are removed from all tabs
+	// before they are added to others. Removing a tab removes its fields.
+	currentFields := make(map[string][]string)
+	for _, tab := range existing {
+		tabEndpoint := fmt.Sprintf("%s/%d", endpoint, tab.ID)
+		if _, ok := tabIDs[tab.Name]; !ok {
+			if err := request(ctx, client, "DELETE", tabEndpoint, nil, nil); err != nil {
+				return errors.Wrapf(err, "removing tab %q failed", tab.Name)
+			}
+			for i, name := range order {
+				if name == tab.Name {
+					order = append(order[:i], order[i+1:]...)
+					break
+				}
+			}
+			continue
+		}
+
+		fields, err := getScreenTabFields(ctx, client, screenID, tab.ID)
+		if err != nil {
+			return err
+		}
+		for _, field := range fields {
+			if containsString(tabFields[tab.Name], field) {
+				currentFields[tab.Name] = append(currentFields[tab.Name], field)
+				continue
+			}
+			err := request(ctx, client, "DELETE", fmt.Sprintf("%s/fields/%s", tabEndpoint, field), nil, nil)
+			if err != nil {
+				return errors.Wrapf(err, "removing field %s from tab %q failed", field, tab.Name)
+			}
+		}
+	}
+
+	for position, name := range names {
+		tabEndpoint := fmt.Sprintf("%s/%d", endpoint, tabIDs[name])
+
+		if order[position] != name {
+			err := request(ctx, client, "POST", fmt.Sprintf("%s/move/%d", tabEndpoint, position), nil, nil)
+			if err != nil {
+				return errors.Wrapf(err, "moving tab %q failed", name)
+			}
+			reordered := []string{}
+			for _, n := range order {
+				if n != name {
+					reordered = append(reordered, n)
+				}
+			}
+			order = append(reordered[:position], append([]string{name}, reordered[position:]...)...)
+		}
+
+		current := currentFields[name]
+		for _, field := range tabFields[name] {
+			if containsString(current, field) {
+				continue
+			}
+			body := map[string]string{"fieldId": field}
+			err := request(ctx, client, "POST", tabEndpoint+"/fields", body, nil)
+			if err != nil {
+				return errors.Wrapf(err, "adding field %s to tab %q failed", field, name)
+			}
+			current = append(current, field)
+		}
+
+		// Moving each field to the end results in the configured order
+		if !stringSlicesEqual(current, tabFields[name]) {
+			for _, field := range tabFields[name] {
+				move := map[string]string{"position": "Last"}
+				err := request(ctx, client, "POST", fmt.Sprintf("%s/fields/%s/move", tabEndpoint, field), move, nil)
+				if err != nil {
+					return errors.Wrapf(err, "moving field %s on tab %q failed", field, name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceScreenCreate creates a new jira screen using the jira api
+func resourceScreenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	screen := &Screen{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	returnedScreen := new(Screen)
+	err := request(ctx, config.jiraClient, "POST", screenAPIEndpoint, screen, returnedScreen)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira screen failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedScreen.ID))
+
+	if err := syncScreenTabs(ctx, config.jiraClient, d.Id(), d.Get("tab").([]interface{})); err != nil {
+		return errorDiagnostics(err, "creating tabs of jira screen failed", nil)
+	}
+
+	return resourceScreenRead(ctx, d, m)
+}
+
+// resourceScreenRead reads screen details using jira api
+func resourceScreenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	screen, err := getScreen(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira screen failed", nil)
+	}
+	if screen == nil {
+		d.SetId("")
+		return nil
+	}
+
+	tabs := []ScreenTab{}
+	err = request(ctx, config.jiraClient, "GET", fmt.Sprintf("%s/tabs", screenEndpoint(d.Id())), nil, &tabs)
+	if err != nil {
+		return errorDiagnostics(err, "reading tabs of jira screen failed", nil)
+	}
+
+	tabList := make([]map[string]interface{}, 0, len(tabs))
+	for _, tab := range tabs {
+		fields, err := getScreenTabFields(ctx, config.jiraClient, d.Id(), tab.ID)
+		if err != nil {
+			return errorDiagnostics(err, "reading fields of jira screen tab failed", nil)
+		}
+		tabList = append(tabList, map[string]interface{}{
+			"id":     strconv.Itoa(tab.ID),
+			"name":   tab.Name,
+			"fields": fields,
+		})
+	}
+
+	d.Set("name", screen.Name)
+	d.Set("description", screen.Description)
+	d.Set("tab", tabList)
+
+	return nil
+}
+
+// resourceScreenUpdate updates jira screen using jira api
+func resourceScreenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if d.HasChanges("name", "description") {
+		screen := &Screen{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		err := request(ctx, config.jiraClient, "PUT", screenEndpoint(d.Id()), screen, nil)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira screen failed", nil)
+		}
+	}
+
+	if d.HasChange("tab") {
+		if err := syncScreenTabs(ctx, config.jiraClient, d.Id(), d.Get("tab").([]interface{})); err != nil {
+			return errorDiagnostics(err, "updating tabs of jira screen failed", nil)
+		}
+	}
+
+	return resourceScreenRead(ctx, d, m)
+}
+
+// resourceScreenDelete deletes jira screen using the jira api
+func resourceScreenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", screenEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira screen failed", nil)
+	}
+
+	return nil
+}
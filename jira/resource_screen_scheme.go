@@ -0,0 +1,199 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// ScreenSchemeScreens maps the issue operations to the IDs of screens
+type ScreenSchemeScreens struct {
+	Default int `json:"default,omitempty"`
+	Create  int `json:"create,omitempty"`
+	Edit    int `json:"edit,omitempty"`
+	View    int `json:"view,omitempty"`
+}
+
+// ScreenScheme The struct sent to and returned by the JIRA instance to manage screen schemes
+type ScreenScheme struct {
+	ID          int                 `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Screens     ScreenSchemeScreens `json:"screens"`
+}
+
+// screenSchemeOperations are the operations which can be mapped to screens,
+// other than the default
+var screenSchemeOperations = []string{"create", "edit", "view"}
+
+// resourceScreenScheme is used to define a JIRA screen scheme
+func resourceScreenScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScreenSchemeCreate,
+		ReadContext:   resourceScreenSchemeRead,
+		UpdateContext: resourceScreenSchemeUpdate,
+		DeleteContext: resourceScreenSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a screen scheme, which maps the create, edit and view operations of issues to screens. " +
+			"The ID can be used in jira_issue_type_screen_scheme",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the screen scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the screen scheme",
+			},
+			"default_screen_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "ID of the screen used for operations without a screen",
+			},
+			"create_screen_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the screen shown when creating issues",
+			},
+			"edit_screen_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the screen shown when editing issues",
+			},
+			"view_screen_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the screen shown when viewing issues",
+			},
+		},
+	}
+}
+
+func screenSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", screenSchemeAPIEndpoint, id)
+}
+
+// getScreenScheme returns the screen scheme, or nil if it does not exist
+func getScreenScheme(ctx context.Context, client *jira.Client, id string) (*ScreenScheme, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	var scheme *ScreenScheme
+	err := requestPages(ctx, client, screenSchemeAPIEndpoint, query, func(value json.RawMessage) error {
+		scheme = new(ScreenScheme)
+		return json.Unmarshal(value, scheme)
+	})
+	return scheme, err
+}
+
+// resourceScreenSchemeCreate creates a new jira screen scheme using the jira api
+func resourceScreenSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := &ScreenScheme{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Screens: ScreenSchemeScreens{
+			Default: d.Get("default_screen_id").(int),
+			Create:  d.Get("create_screen_id").(int),
+			Edit:    d.Get("edit_screen_id").(int),
+			View:    d.Get("view_screen_id").(int),
+		},
+	}
+
+	returnedScheme := new(ScreenScheme)
+	err := request(ctx, config.jiraClient, "POST", screenSchemeAPIEndpoint, scheme, returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira screen scheme failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedScheme.ID))
+
+	return resourceScreenSchemeRead(ctx, d, m)
+}
+
+// resourceScreenSchemeRead reads screen scheme details using jira api
+func resourceScreenSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme, err := getScreenScheme(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira screen scheme failed", nil)
+	}
+	if scheme == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("default_screen_id", scheme.Screens.Default)
+	d.Set("create_screen_id", scheme.Screens.Create)
+	d.Set("edit_screen_id", scheme.Screens.Edit)
+	d.Set("view_screen_id", scheme.Screens.View)
+
+	return nil
+}
+
+// resourceScreenSchemeUpdate updates jira screen scheme using jira api
+func resourceScreenSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	// JIRA expects the IDs as strings when updating, and null removes a screen
+	screens := map[string]interface{}{
+		"default": strconv.Itoa(d.Get("default_screen_id").(int)),
+	}
+	for _, operation := range screenSchemeOperations {
+		screens[operation] = nil
+		if id := d.Get(operation + "_screen_id").(int); id != 0 {
+			screens[operation] = strconv.Itoa(id)
+		}
+	}
+	body := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"screens":     screens,
+	}
+
+	err := request(ctx, config.jiraClient, "PUT", screenSchemeEndpoint(d.Id()), body, nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira screen scheme failed", nil)
+	}
+
+	return resourceScreenSchemeRead(ctx, d, m)
+}
+
+// resourceScreenSchemeDelete deletes jira screen scheme using the jira api
+func resourceScreenSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", screenSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira screen scheme failed", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraScreenScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_screen_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraScreenSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraScreenSchemeConfig(rInt, "foo", `
+  default_screen_id = jira_screen.foo.id
+  create_screen_id  = jira_screen.bar.id
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_screen_id", "jira_screen.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "create_screen_id", "jira_screen.bar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "edit_screen_id", "0"),
+				),
+			},
+			{
+				Config: testAccJiraScreenSchemeConfig(rInt, "bar", `
+  default_screen_id = jira_screen.bar.id
+  edit_screen_id    = jira_screen.foo.id
+  view_screen_id    = jira_screen.foo.id
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "default_screen_id", "jira_screen.bar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "create_screen_id", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "edit_screen_id", "jira_screen.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "view_screen_id", "jira_screen.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraScreenScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_screen_scheme.foo"
+	var id string
+
+	config := testAccJiraScreenSchemeConfig(rInt, "foo", `
+  default_screen_id = jira_screen.foo.id
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraScreenSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", screenSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenSchemeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraScreenSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_screen_scheme" {
+			continue
+		}
+
+		scheme, err := getScreenScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme != nil {
+			return fmt.Errorf("Screen scheme %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraScreenSchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No screen scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		scheme, err := getScreenScheme(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if scheme == nil {
+			return fmt.Errorf("Screen scheme %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraScreenSchemeConfig(rInt int, name string, screens string) string {
+	return fmt.Sprintf(`
+resource "jira_screen" "foo" {
+  name = "foo-screen-%d"
+
+  tab {
+    name   = "General"
+    fields = ["summary"]
+  }
+}
+
+resource "jira_screen" "bar" {
+  name = "bar-screen-%d"
+
+  tab {
+    name   = "General"
+    fields = ["summary", "description"]
+  }
+}
+
+resource "jira_screen_scheme" "foo" {
+  name        = "%s-scheme-%d"
+  description = "Created by Terraform"
+%s
+}
+`, rInt, rInt, name, rInt, screens)
+}
@@ -0,0 +1,181 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraScreen_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_screen.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraScreenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraScreenConfig(rInt, "foo", `
+  tab {
+    name   = "General"
+    fields = ["summary", "description"]
+  }
+
+  tab {
+    name   = "Details"
+    fields = ["labels"]
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-screen-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "tab.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.name", "General"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.fields.1", "description"),
+					resource.TestCheckResourceAttr(resourceName, "tab.1.name", "Details"),
+					resource.TestCheckResourceAttrSet(resourceName, "tab.1.id"),
+				),
+			},
+			{
+				// Tabs are reordered and fields move between tabs
+				Config: testAccJiraScreenConfig(rInt, "bar", `
+  tab {
+    name   = "Details"
+    fields = ["assignee", "labels", "description"]
+  }
+
+  tab {
+    name   = "General"
+    fields = ["summary"]
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-screen-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.name", "Details"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.fields.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.fields.0", "assignee"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.fields.2", "description"),
+					resource.TestCheckResourceAttr(resourceName, "tab.1.name", "General"),
+				),
+			},
+			{
+				Config: testAccJiraScreenConfig(rInt, "bar", `
+  tab {
+    name   = "Main"
+    fields = ["summary", "duedate"]
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tab.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.name", "Main"),
+					resource.TestCheckResourceAttr(resourceName, "tab.0.fields.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraScreen_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_screen.foo"
+	var id string
+
+	config := testAccJiraScreenConfig(rInt, "foo", `
+  tab {
+    name   = "General"
+    fields = ["summary"]
+  }
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraScreenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", screenEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraScreenExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraScreenDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_screen" {
+			continue
+		}
+
+		screen, err := getScreen(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if screen != nil {
+			return fmt.Errorf("Screen %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraScreenExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No screen ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		screen, err := getScreen(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if screen == nil {
+			return fmt.Errorf("Screen %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraScreenConfig(rInt int, name string, tabs string) string {
+	return fmt.Sprintf(`
+resource "jira_screen" "foo" {
+  name        = "%s-screen-%d"
+  description = "Created by Terraform"
+%s
+}
+`, name, rInt, tabs)
+}
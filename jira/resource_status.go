@@ -0,0 +1,170 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// StatusDetails The struct sent to and returned by the JIRA instance to manage statuses
+type StatusDetails struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	StatusCategory string `json:"statusCategory"`
+}
+
+// statusCategories are the categories a status can belong to
+var statusCategories = []string{"TODO", "IN_PROGRESS", "DONE"}
+
+// resourceStatus is used to define a JIRA status
+func resourceStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStatusCreate,
+		ReadContext:   resourceStatusRead,
+		UpdateContext: resourceStatusUpdate,
+		DeleteContext: resourceStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a global status. The ID can be used in jira_workflow",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the status",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the status",
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(statusCategories, false),
+				Description:  "Category of the status, one of TODO, IN_PROGRESS or DONE",
+			},
+		},
+	}
+}
+
+// getStatus returns the status, or nil if it does not exist
+func getStatus(ctx context.Context, client *jira.Client, id string) (*StatusDetails, error) {
+	query := url.Values{}
+	query.Set("id", id)
+
+	statuses := []StatusDetails{}
+	urlStr := fmt.Sprintf("%s?%s", statusesAPIEndpoint, query.Encode())
+	if err := request(ctx, client, "GET", urlStr, nil, &statuses); err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		if status.ID == id {
+			return &status, nil
+		}
+	}
+	return nil, nil
+}
+
+// resourceStatusCreate creates a new jira status using the jira api
+func resourceStatusCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	body := map[string]interface{}{
+		"scope": map[string]string{"type": "GLOBAL"},
+		"statuses": []StatusDetails{{
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			StatusCategory: d.Get("category").(string),
+		}},
+	}
+
+	returnedStatuses := []StatusDetails{}
+	err := request(ctx, config.jiraClient, "POST", statusesAPIEndpoint, body, &returnedStatuses)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira status failed", nil)
+	}
+	if len(returnedStatuses) != 1 {
+		return diag.Errorf("creating jira status failed: JIRA returned %d statuses", len(returnedStatuses))
+	}
+
+	d.SetId(returnedStatuses[0].ID)
+
+	return resourceStatusRead(ctx, d, m)
+}
+
+// resourceStatusRead reads status details using jira api
+func resourceStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	status, err := getStatus(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira status failed", nil)
+	}
+	if status == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", status.Name)
+	d.Set("description", status.Description)
+	d.Set("category", status.StatusCategory)
+
+	return nil
+}
+
+// resourceStatusUpdate updates jira status using jira api
+func resourceStatusUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	body := map[string]interface{}{
+		"statuses": []StatusDetails{{
+			ID:             d.Id(),
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			StatusCategory: d.Get("category").(string),
+		}},
+	}
+
+	err := request(ctx, config.jiraClient, "PUT", statusesAPIEndpoint, body, nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira status failed", nil)
+	}
+
+	return resourceStatusRead(ctx, d, m)
+}
+
+// resourceStatusDelete deletes jira status using the jira api
+func resourceStatusDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	query := url.Values{}
+	query.Set("id", d.Id())
+
+	urlStr := fmt.Sprintf("%s?%s", statusesAPIEndpoint, query.Encode())
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira status failed", nil)
+	}
+
+	return nil
+}
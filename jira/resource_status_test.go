@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraStatus_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_status.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraStatusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraStatusConfig(rInt, "foo", "TODO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraStatusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-status-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "category", "TODO"),
+				),
+			},
+			{
+				Config: testAccJiraStatusConfig(rInt, "bar", "IN_PROGRESS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraStatusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-status-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "category", "IN_PROGRESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraStatus_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_status.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraStatusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraStatusConfig(rInt, "foo", "DONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					query := url.Values{}
+					query.Set("id", id)
+					urlStr := fmt.Sprintf("%s?%s", statusesAPIEndpoint, query.Encode())
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					if err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraStatusConfig(rInt, "foo", "DONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraStatusExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraStatusDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_status" {
+			continue
+		}
+
+		status, err := getStatus(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if status != nil {
+			return fmt.Errorf("Status %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraStatusExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No status ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		status, err := getStatus(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if status == nil {
+			return fmt.Errorf("Status %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraStatusConfig(rInt int, name string, category string) string {
+	return fmt.Sprintf(`
+resource "jira_status" "foo" {
+  name        = "%s-status-%d"
+  description = "Created by Terraform"
+  category    = "%s"
+}
+`, name, rInt, category)
+}
@@ -1,8 +1,11 @@
 package jira
 
 import (
+	"context"
 	"fmt"
+
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -17,11 +20,16 @@ func usernameFallbackSuppressFunc(k, old, new string, d *schema.ResourceData) bo
 // resourceUser is used to define a JIRA issue
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceUserCreate,
-		Read:   resourceUserRead,
-		Delete: resourceUserDelete,
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		DeleteContext: resourceUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -44,9 +52,9 @@ func resourceUser() *schema.Resource {
 	}
 }
 
-func getUserByKey(client *jira.Client, key string) (*jira.User, *jira.Response, error) {
+func getUserByKey(ctx context.Context, client *jira.Client, key string) (*jira.User, *jira.Response, error) {
 	apiEndpoint := fmt.Sprintf("%s?key=%s", userAPIEndpoint, key)
-	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,27 +62,27 @@ func getUserByKey(client *jira.Client, key string) (*jira.User, *jira.Response,
 	user := new(jira.User)
 	resp, err := client.Do(req, user)
 	if err != nil {
-		return nil, resp, jira.NewJiraError(resp, err)
+		return nil, resp, newAPIError("GET", apiEndpoint, resp, err)
 	}
 	return user, resp, nil
 }
 
-func deleteUserByKey(client *jira.Client, key string) (*jira.Response, error) {
+func deleteUserByKey(ctx context.Context, client *jira.Client, key string) (*jira.Response, error) {
 	apiEndpoint := fmt.Sprintf("%s?key=%s", userAPIEndpoint, key)
-	req, err := client.NewRequest("DELETE", apiEndpoint, nil)
+	req, err := client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	resp, err := client.Do(req, nil)
 	if err != nil {
-		return resp, jira.NewJiraError(resp, err)
+		return resp, newAPIError("DELETE", apiEndpoint, resp, err)
 	}
 	return resp, nil
 }
 
 // resourceUserCreate creates a new jira user using the jira api
-func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	user := new(jira.User)
@@ -88,24 +96,28 @@ func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
 		user.DisplayName = user.Name
 	}
 
-	createdUser, _, err := config.jiraClient.User.Create(user)
+	createdUser, _, err := config.jiraClient.User.CreateWithContext(ctx, user)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira user failed", nil)
 	}
 
 	d.SetId(createdUser.Key)
 
-	return resourceUserRead(d, m)
+	return resourceUserRead(ctx, d, m)
 }
 
 // resourceUserRead reads issue details using jira api
-func resourceUserRead(d *schema.ResourceData, m interface{}) error {
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
-	user, _, err := getUserByKey(config.jiraClient, d.Id())
+	user, _, err := getUserByKey(ctx, config.jiraClient, d.Id())
 	if err != nil {
-		return errors.Wrap(err, "getting jira user failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira user failed", nil)
 	}
 
 	d.Set("name", user.Name)
@@ -115,13 +127,13 @@ func resourceUserRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceUserDelete deletes jira issue using the jira api
-func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
-	_, err := deleteUserByKey(config.jiraClient, d.Id())
+	_, err := deleteUserByKey(ctx, config.jiraClient, d.Id())
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira user failed", nil)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -34,6 +35,39 @@ func TestAccJiraUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraUser_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_user.foo"
+	var key string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraUserConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &key),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					_, err := deleteUserByKey(context.Background(), jiraClient, key)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraUserConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraUserExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckJiraUserDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Config).jiraClient
 
@@ -43,7 +77,7 @@ func testAccCheckJiraUserDestroy(s *terraform.State) error {
 		}
 		id := rs.Primary.ID
 
-		_, resp, _ := getUserByKey(client, id)
+		_, resp, _ := getUserByKey(context.Background(), client, id)
 
 		if resp.StatusCode != 404 {
 			return fmt.Errorf("User %q still exists", rs.Primary.ID)
@@ -67,7 +101,7 @@ func testAccCheckJiraUserExists(n string) resource.TestCheckFunc {
 		}
 
 		client := testAccProvider.Meta().(*Config).jiraClient
-		_, resp, _ := getUserByKey(client, rs.Primary.ID)
+		_, resp, _ := getUserByKey(context.Background(), client, rs.Primary.ID)
 
 		if resp.StatusCode != 200 {
 			return fmt.Errorf("User %q does not exists", rs.Primary.ID)
@@ -0,0 +1,208 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// VersionRequest The struct sent to the JIRA instance to create or update a version
+type VersionRequest struct {
+	Name                string  `json:"name"`
+	Description         string  `json:"description"`
+	Project             string  `json:"project,omitempty"`
+	StartDate           *string `json:"startDate"`
+	ReleaseDate         *string `json:"releaseDate"`
+	Released            bool    `json:"released"`
+	Archived            bool    `json:"archived"`
+	MoveUnfixedIssuesTo string  `json:"moveUnfixedIssuesTo,omitempty"`
+}
+
+func resourceVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVersionCreate,
+		ReadContext:   resourceVersionRead,
+		UpdateContext: resourceVersionUpdate,
+		DeleteContext: resourceVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a project version",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the version",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the version",
+			},
+
+			"project_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project Key (for example PRJ)",
+			},
+
+			"project_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Start date of the version (for example 2021-05-31)",
+			},
+
+			"release_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+				Description:  "Release date of the version (for example 2021-06-30)",
+			},
+
+			"released": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the version has been released",
+			},
+
+			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the version is archived",
+			},
+
+			"move_unfixed_issues_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the version unresolved issues are moved to, when the version is released",
+			},
+		},
+	}
+}
+
+func versionEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", versionAPIEndpoint, id)
+}
+
+func setVersion(w *VersionRequest, d *schema.ResourceData) {
+	w.Name = d.Get("name").(string)
+	w.Description = d.Get("description").(string)
+	// Dates removed from the configuration are cleared by sending null
+	w.StartDate = nullableString(d.Get("start_date").(string))
+	w.ReleaseDate = nullableString(d.Get("release_date").(string))
+	w.Released = d.Get("released").(bool)
+	w.Archived = d.Get("archived").(bool)
+}
+
+func resourceVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	version := new(VersionRequest)
+	setVersion(version, d)
+	version.Project = d.Get("project_key").(string)
+
+	returnedVersion := new(jira.Version)
+	err := request(ctx, config.jiraClient, "POST", versionAPIEndpoint, version, returnedVersion)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira version failed", nil)
+	}
+
+	d.SetId(returnedVersion.ID)
+
+	return resourceVersionRead(ctx, d, m)
+}
+
+func resourceVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	version := new(jira.Version)
+	err := request(ctx, config.jiraClient, "GET", versionEndpoint(d.Id()), nil, version)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira version failed", nil)
+	}
+
+	// Versions only reference their project by ID, the key is resolved when
+	// it is unknown, e.g. after an import
+	if d.Get("project_id").(int) != version.ProjectID || d.Get("project_key").(string) == "" {
+		project := new(Project)
+		urlStr := fmt.Sprintf("%s/%d", projectAPIEndpoint, version.ProjectID)
+		err := request(ctx, config.jiraClient, "GET", urlStr, nil, project)
+		if err != nil {
+			return errorDiagnostics(err, "reading jira version failed", nil)
+		}
+		d.Set("project_key", project.Key)
+	}
+
+	d.Set("name", version.Name)
+	d.Set("description", version.Description)
+	d.Set("project_id", version.ProjectID)
+	d.Set("start_date", version.StartDate)
+	d.Set("release_date", version.ReleaseDate)
+	d.Set("released", version.Released != nil && *version.Released)
+	d.Set("archived", version.Archived != nil && *version.Archived)
+
+	return nil
+}
+
+func resourceVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	version := new(VersionRequest)
+	setVersion(version, d)
+
+	// JIRA expects the URL of the version the issues are moved to
+	if target, ok := d.GetOk("move_unfixed_issues_to"); ok && d.HasChange("released") && version.Released {
+		baseURL := config.jiraClient.GetBaseURL()
+		targetURL, err := baseURL.Parse(versionEndpoint(target.(string)))
+		if err != nil {
+			return errorDiagnostics(err, "updating jira version failed", nil)
+		}
+		version.MoveUnfixedIssuesTo = targetURL.String()
+	}
+
+	err := request(ctx, config.jiraClient, "PUT", versionEndpoint(d.Id()), version, nil)
+	if err != nil {
+		return errorDiagnostics(err, "updating jira version failed", nil)
+	}
+
+	return resourceVersionRead(ctx, d, m)
+}
+
+func resourceVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", versionEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira version failed", nil)
+	}
+
+	return nil
+}
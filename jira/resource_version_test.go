@@ -0,0 +1,187 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraVersion_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_version.foo"
+	var issueKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraVersionConfig(rInt, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "released", "false"),
+					resource.TestCheckResourceAttr(resourceName, "release_date", "2021-06-30"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "jira_project.foo", "project_id"),
+				),
+			},
+			{
+				// Create an unresolved issue scheduled for the version
+				PreConfig: func() {
+					issueKey = testAccCreateIssueWithFixVersion(t, rInt)
+				},
+				Config: testAccJiraVersionConfig(rInt, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "released", "true"),
+					testAccCheckJiraIssueFixVersion(&issueKey, "jira_version.next"),
+				),
+			},
+			{
+				Config: testAccJiraVersionConfig(rInt, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "start_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "release_date", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"move_unfixed_issues_to"},
+			},
+		},
+	})
+}
+
+// testAccCreateIssueWithFixVersion creates an issue, which is scheduled for the
+// version foo-version-<rInt>. The issue is deleted along with the project.
+func testAccCreateIssueWithFixVersion(t *testing.T, rInt int) string {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	project, _, err := client.Project.Get(fmt.Sprintf("PX%d", rInt%100000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fixVersion *jira.FixVersion
+	for _, version := range project.Versions {
+		if version.Name == fmt.Sprintf("foo-version-%d", rInt) {
+			fixVersion = &jira.FixVersion{ID: version.ID}
+		}
+	}
+	if fixVersion == nil {
+		t.Fatalf("version foo-version-%d not found", rInt)
+	}
+
+	issue, _, err := client.Issue.Create(&jira.Issue{
+		Fields: &jira.IssueFields{
+			Project:     jira.Project{Key: project.Key},
+			Type:        jira.IssueType{Name: "Task"},
+			Summary:     "Scheduled for release",
+			FixVersions: []*jira.FixVersion{fixVersion},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issue.Key
+}
+
+func testAccCheckJiraIssueFixVersion(issueKey *string, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		issue, _, err := client.Issue.Get(*issueKey, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(issue.Fields.FixVersions) != 1 || issue.Fields.FixVersions[0].ID != rs.Primary.ID {
+			return fmt.Errorf("Issue %s was not moved to version %s", *issueKey, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckJiraVersionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_version" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", versionEndpoint(rs.Primary.ID), nil, nil)
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Version %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No version ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		err := request(context.Background(), client, "GET", versionEndpoint(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Version %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraVersionConfig(rInt int, released bool, dated bool) string {
+	dates := ""
+	if dated {
+		dates = `
+  start_date             = "2021-06-01"
+  release_date           = "2021-06-30"`
+	}
+
+	return fmt.Sprintf(`
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = "${jira_user.foo.name}"
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+}
+
+resource "jira_version" "foo" {
+  name                   = "foo-version-%d"
+  description            = "Created by Terraform"
+  project_key            = "${jira_project.foo.key}"%s
+  released               = %t
+  move_unfixed_issues_to = "${jira_version.next.id}"
+}
+
+resource "jira_version" "next" {
+  name        = "next-version-%d"
+  project_key = "${jira_project.foo.key}"
+}
+`, rInt, rInt, rInt%100000, rInt, dates, released, rInt)
+}
@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -25,12 +27,18 @@ type Webhook struct {
 
 func resourceWebhook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWebhookCreate,
-		Read:   resourceWebhookRead,
-		Update: resourceWebhookUpdate,
-		Delete: resourceWebhookDelete,
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -92,7 +100,7 @@ func setWebhookResource(w *Webhook, d *schema.ResourceData) {
 }
 
 // resourceWebhookCreate creates a new jira issue using the jira api
-func resourceWebhookCreate(d *schema.ResourceData, m interface{}) error {
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	webhook := new(Webhook)
@@ -100,27 +108,31 @@ func resourceWebhookCreate(d *schema.ResourceData, m interface{}) error {
 
 	setWebhook(webhook, d)
 
-	err := request(config.jiraClient, "POST", webhookAPIEndpoint, webhook, returnedWebhook)
+	err := request(ctx, config.jiraClient, "POST", webhookAPIEndpoint, webhook, returnedWebhook)
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "creating jira webhook failed", nil)
 	}
 
 	setWebhookResource(returnedWebhook, d)
 
-	return resourceWebhookRead(d, m)
+	return resourceWebhookRead(ctx, d, m)
 }
 
 // resourceWebhookRead reads issue details using jira api
-func resourceWebhookRead(d *schema.ResourceData, m interface{}) error {
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", webhookAPIEndpoint, d.Id())
 
 	webhook := new(Webhook)
-	err := request(config.jiraClient, "GET", urlStr, nil, webhook)
+	err := request(ctx, config.jiraClient, "GET", urlStr, nil, webhook)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira webhook failed", nil)
 	}
 
 	setWebhookResource(webhook, d)
@@ -129,7 +141,7 @@ func resourceWebhookRead(d *schema.ResourceData, m interface{}) error {
 }
 
 // resourceWebhookUpdate updates jira issue using jira api
-func resourceWebhookUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	webhook := new(Webhook)
@@ -139,25 +151,25 @@ func resourceWebhookUpdate(d *schema.ResourceData, m interface{}) error {
 	urlStr := fmt.Sprintf("%s/%s", webhookAPIEndpoint, d.Id())
 	returnedWebhook := new(Webhook)
 
-	err := request(config.jiraClient, "PUT", urlStr, webhook, returnedWebhook)
+	err := request(ctx, config.jiraClient, "PUT", urlStr, webhook, returnedWebhook)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "updating jira webhook failed", nil)
 	}
 
-	return resourceWebhookRead(d, m)
+	return resourceWebhookRead(ctx, d, m)
 }
 
 // resourceWebhookDelete deletes jira issue using the jira api
-func resourceWebhookDelete(d *schema.ResourceData, m interface{}) error {
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(*Config)
 
 	urlStr := fmt.Sprintf("%s/%s", webhookAPIEndpoint, d.Id())
 
-	err := request(config.jiraClient, "DELETE", urlStr, nil, nil)
+	err := request(ctx, config.jiraClient, "DELETE", urlStr, nil, nil)
 
 	if err != nil {
-		return errors.Wrap(err, "Request failed")
+		return errorDiagnostics(err, "deleting jira webhook failed", nil)
 	}
 
 	return nil
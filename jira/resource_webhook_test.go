@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraWebhook_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_webhook.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraWebhookConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWebhookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraWebhook_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_webhook.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraWebhookConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					urlStr := fmt.Sprintf("%s/%s", webhookAPIEndpoint, id)
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", urlStr, nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraWebhookConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWebhookExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraWebhookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_webhook" {
+			continue
+		}
+
+		urlStr := fmt.Sprintf("%s/%s", webhookAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if !errors.Is(err, ResourceNotFoundError) {
+			return fmt.Errorf("Webhook %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No webhook ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		urlStr := fmt.Sprintf("%s/%s", webhookAPIEndpoint, rs.Primary.ID)
+		err := request(context.Background(), client, "GET", urlStr, nil, nil)
+
+		if err != nil {
+			return fmt.Errorf("Webhook %q does not exist: %s", rs.Primary.ID, err)
+		}
+		return nil
+	}
+}
+
+func testAccJiraWebhookConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_webhook" "foo" {
+  name   = "foo-webhook-%d"
+  url    = "https://example.org/webhook"
+  jql    = "project = PX"
+  events = ["jira:issue_created"]
+}`, rInt)
+}
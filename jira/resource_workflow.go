@@ -0,0 +1,568 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+)
+
+// WorkflowID identifies a workflow
+type WorkflowID struct {
+	Name     string `json:"name"`
+	EntityID string `json:"entityId,omitempty"`
+}
+
+// WorkflowStatus is a status of a workflow
+type WorkflowStatus struct {
+	ID         string            `json:"id"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// WorkflowCondition is a node of the condition tree of a transition. Simple
+// nodes have a type, compound nodes combine their conditions using the operator.
+type WorkflowCondition struct {
+	NodeType      string              `json:"nodeType,omitempty"`
+	Operator      string              `json:"operator,omitempty"`
+	Conditions    []WorkflowCondition `json:"conditions,omitempty"`
+	Type          string              `json:"type,omitempty"`
+	Configuration json.RawMessage     `json:"configuration,omitempty"`
+}
+
+// WorkflowRule is a validator or post function of a transition
+type WorkflowRule struct {
+	Type          string          `json:"type"`
+	Configuration json.RawMessage `json:"configuration,omitempty"`
+}
+
+// WorkflowRules are the rules of a transition. JIRA expects the conditions as
+// conditions and returns them as conditionsTree.
+type WorkflowRules struct {
+	Conditions     *WorkflowCondition `json:"conditions,omitempty"`
+	ConditionsTree *WorkflowCondition `json:"conditionsTree,omitempty"`
+	Validators     []WorkflowRule     `json:"validators,omitempty"`
+	PostFunctions  []WorkflowRule     `json:"postFunctions,omitempty"`
+}
+
+// WorkflowScreen references the screen of a transition
+type WorkflowScreen struct {
+	ID string `json:"id"`
+}
+
+// WorkflowTransition is a transition of a workflow
+type WorkflowTransition struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	From        []string          `json:"from"`
+	To          string            `json:"to"`
+	Type        string            `json:"type"`
+	Screen      *WorkflowScreen   `json:"screen,omitempty"`
+	Rules       *WorkflowRules    `json:"rules,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+}
+
+// Workflow The struct returned by the JIRA instance when searching workflows
+type Workflow struct {
+	ID          WorkflowID           `json:"id"`
+	Description string               `json:"description"`
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+// WorkflowRequest The struct sent to the JIRA instance to create a workflow
+type WorkflowRequest struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Statuses    []WorkflowStatus     `json:"statuses"`
+	Transitions []WorkflowTransition `json:"transitions"`
+}
+
+var workflowTransitionTypes = []string{"initial", "directed", "global"}
+
+// workflowRuleSchema returns the schema of the conditions, validators and post
+// functions of a transition
+func workflowRuleSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Type of the rule (for example UserInGroupCondition)",
+				},
+				"configuration": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: structure.SuppressJsonDiff,
+					Description:      "Configuration of the rule as JSON object",
+				},
+			},
+		},
+	}
+}
+
+// resourceWorkflow is used to define a JIRA workflow
+func resourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkflowCreate,
+		ReadContext:   resourceWorkflowRead,
+		DeleteContext: resourceWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a workflow. The ID is the name of the workflow, which can be used in jira_workflow_scheme. " +
+			"JIRA can't modify workflows using its REST API, so any change replaces the workflow. " +
+			"Workflows used by a workflow scheme can't be deleted",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the workflow",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the workflow",
+			},
+			"status": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"definition_json"},
+				Description:   "Statuses of the workflow",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the status",
+						},
+						"properties": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Properties of the status (for example jira.issue.editable)",
+						},
+					},
+				},
+			},
+			"transition": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"definition_json"},
+				Description: "Transitions of the workflow. A workflow needs exactly one initial transition. " +
+					"Rules and properties JIRA adds to configured transitions, like its essential post functions, are ignored",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the transition",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the transition",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "directed",
+							Description: "Type of the transition. Needs to be one of initial, directed or global",
+							ValidateFunc: func(v interface{}, s string) ([]string, []error) {
+								if !containsString(workflowTransitionTypes, v.(string)) {
+									return nil, []error{fmt.Errorf("type needs to be one of initial, directed or global")}
+								}
+								return nil, nil
+							},
+						},
+						"from": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the statuses the transition starts from. Must be empty for initial and global transitions",
+						},
+						"to": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the status the transition leads to",
+						},
+						"screen_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the screen shown during the transition",
+						},
+						"properties": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Properties of the transition",
+						},
+						"condition_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "AND",
+							ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, false),
+							Description:  "Whether all (AND) or any (OR) of the conditions need to be met",
+						},
+						"condition":     workflowRuleSchema("Conditions which need to be met to perform the transition"),
+						"validator":     workflowRuleSchema("Validators which check the input of the transition"),
+						"post_function": workflowRuleSchema("Functions performed after the transition"),
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"definition_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ConflictsWith:    []string{"status", "transition"},
+				Description: "Statuses and transitions as JSON object with the attributes statuses and transitions " +
+					"as expected by the JIRA API. Can be used instead of status and transition",
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func workflowEndpoint(entityID string) string {
+	return fmt.Sprintf("%s/%s", workflowAPIEndpoint, entityID)
+}
+
+// getWorkflow returns the workflow with the given name, or nil if there is none
+func getWorkflow(ctx context.Context, client *jira.Client, name string) (*Workflow, error) {
+	query := url.Values{}
+	query.Set("workflowName", name)
+	query.Set("expand", "statuses,statuses.properties,transitions,transitions.rules,transitions.properties")
+
+	var workflow *Workflow
+	err := requestPages(ctx, client, workflowAPIEndpoint+"/search", query, func(value json.RawMessage) error {
+		w := new(Workflow)
+		if err := json.Unmarshal(value, w); err != nil {
+			return err
+		}
+		if w.ID.Name == name {
+			workflow = w
+		}
+		return nil
+	})
+	return workflow, err
+}
+
+func expandWorkflowRules(v interface{}) []WorkflowRule {
+	var rules []WorkflowRule
+	for _, r := range v.([]interface{}) {
+		m := r.(map[string]interface{})
+		rule := WorkflowRule{Type: m["type"].(string)}
+		if configuration := m["configuration"].(string); configuration != "" {
+			rule.Configuration = json.RawMessage(configuration)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenWorkflowRules(rules []WorkflowRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		configuration := ""
+		if len(rule.Configuration) > 0 && string(rule.Configuration) != "null" {
+			configuration = string(rule.Configuration)
+		}
+		result = append(result, map[string]interface{}{
+			"type":          rule.Type,
+			"configuration": configuration,
+		})
+	}
+	return result
+}
+
+func expandWorkflowRequest(d *schema.ResourceData) (*WorkflowRequest, error) {
+	workflow := &WorkflowRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if definition, ok := d.GetOk("definition_json"); ok {
+		if err := json.Unmarshal([]byte(definition.(string)), workflow); err != nil {
+			return nil, errors.Wrap(err, "decoding definition_json failed")
+		}
+		// The attributes take precedence over the definition
+		workflow.Name = d.Get("name").(string)
+		workflow.Description = d.Get("description").(string)
+		return workflow, nil
+	}
+
+	for _, v := range d.Get("status").([]interface{}) {
+		m := v.(map[string]interface{})
+		workflow.Statuses = append(workflow.Statuses, WorkflowStatus{
+			ID:         m["status_id"].(string),
+			Properties: expandStringMap(m["properties"]),
+		})
+	}
+
+	for _, v := range d.Get("transition").([]interface{}) {
+		m := v.(map[string]interface{})
+		transition := WorkflowTransition{
+			Name:        m["name"].(string),
+			Description: m["description"].(string),
+			Type:        m["type"].(string),
+			From:        []string{},
+			To:          m["to"].(string),
+			Properties:  expandStringMap(m["properties"]),
+			Rules: &WorkflowRules{
+				Validators:    expandWorkflowRules(m["validator"]),
+				PostFunctions: expandWorkflowRules(m["post_function"]),
+			},
+		}
+		for _, from := range m["from"].([]interface{}) {
+			transition.From = append(transition.From, from.(string))
+		}
+		if screenID := m["screen_id"].(string); screenID != "" {
+			transition.Screen = &WorkflowScreen{ID: screenID}
+		}
+		if conditions := expandWorkflowRules(m["condition"]); len(conditions) > 0 {
+			transition.Rules.Conditions = &WorkflowCondition{Operator: m["condition_operator"].(string)}
+			for _, condition := range conditions {
+				transition.Rules.Conditions.Conditions = append(transition.Rules.Conditions.Conditions, WorkflowCondition{
+					Type:          condition.Type,
+					Configuration: condition.Configuration,
+				})
+			}
+		}
+		workflow.Transitions = append(workflow.Transitions, transition)
+	}
+
+	return workflow, nil
+}
+
+// flattenWorkflowConditions returns the operator and the conditions of a
+// condition tree. Only trees without nested compound conditions can be
+// represented by the condition blocks.
+func flattenWorkflowConditions(tree *WorkflowCondition) (string, []WorkflowRule, error) {
+	if tree == nil {
+		return "AND", nil, nil
+	}
+	if tree.NodeType != "compound" {
+		return "AND", []WorkflowRule{{Type: tree.Type, Configuration: tree.Configuration}}, nil
+	}
+
+	var conditions []WorkflowRule
+	for _, condition := range tree.Conditions {
+		if condition.NodeType == "compound" {
+			return "", nil, fmt.Errorf("nested conditions are not supported by the condition blocks, use definition_json")
+		}
+		conditions = append(conditions, WorkflowRule{Type: condition.Type, Configuration: condition.Configuration})
+	}
+	return tree.Operator, conditions, nil
+}
+
+func flattenWorkflowTransitions(transitions []WorkflowTransition) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(transitions))
+	for _, transition := range transitions {
+		rules := transition.Rules
+		if rules == nil {
+			rules = &WorkflowRules{}
+		}
+
+		operator, conditions, err := flattenWorkflowConditions(rules.ConditionsTree)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transition %s", transition.Name)
+		}
+
+		screenID := ""
+		if transition.Screen != nil {
+			screenID = transition.Screen.ID
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                 transition.ID,
+			"name":               transition.Name,
+			"description":        transition.Description,
+			"type":               transition.Type,
+			"from":               transition.From,
+			"to":                 transition.To,
+			"screen_id":          screenID,
+			"properties":         transition.Properties,
+			"condition_operator": operator,
+			"condition":          flattenWorkflowRules(conditions),
+			"validator":          flattenWorkflowRules(rules.Validators),
+			"post_function":      flattenWorkflowRules(rules.PostFunctions),
+		})
+	}
+	return result, nil
+}
+
+// configuredWorkflowRules returns the rules read from JIRA which match the
+// configured rules by type, in the configured order
+func configuredWorkflowRules(rules []interface{}, configured []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(configured))
+	used := make([]bool, len(rules))
+	for _, c := range configured {
+		for i, r := range rules {
+			if !used[i] && r.(map[string]interface{})["type"] == c.(map[string]interface{})["type"] {
+				used[i] = true
+				result = append(result, r)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// configuredWorkflowProperties returns the properties read from JIRA which
+// are configured
+func configuredWorkflowProperties(properties map[string]string, configured interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key := range configured.(map[string]interface{}) {
+		if value, ok := properties[key]; ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// normalizeWorkflow reduces the statuses and transitions read from JIRA to the
+// configured rules and properties. JIRA adds rules like its essential post
+// functions to each transition, which would otherwise replace the workflow.
+func normalizeWorkflow(statuses []interface{}, transitions []interface{}, d *schema.ResourceData) {
+	configuredStatuses := make(map[string]map[string]interface{})
+	for _, v := range d.Get("status").([]interface{}) {
+		m := v.(map[string]interface{})
+		configuredStatuses[m["status_id"].(string)] = m
+	}
+	for _, v := range statuses {
+		status := v.(map[string]interface{})
+		if configured, ok := configuredStatuses[status["status_id"].(string)]; ok {
+			status["properties"] = configuredWorkflowProperties(status["properties"].(map[string]string), configured["properties"])
+		}
+	}
+
+	configuredTransitions := make(map[string]map[string]interface{})
+	for _, v := range d.Get("transition").([]interface{}) {
+		m := v.(map[string]interface{})
+		configuredTransitions[m["name"].(string)] = m
+	}
+	for _, v := range transitions {
+		transition := v.(map[string]interface{})
+		configured, ok := configuredTransitions[transition["name"].(string)]
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"condition", "validator", "post_function"} {
+			transition[key] = configuredWorkflowRules(transition[key].([]interface{}), configured[key].([]interface{}))
+		}
+		if len(transition["condition"].([]interface{})) == 0 {
+			transition["condition_operator"] = configured["condition_operator"]
+		}
+		transition["properties"] = configuredWorkflowProperties(transition["properties"].(map[string]string), configured["properties"])
+	}
+}
+
+// resourceWorkflowCreate creates a new jira workflow using the jira api
+func resourceWorkflowCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	workflow, err := expandWorkflowRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	returnedWorkflow := new(WorkflowID)
+	err = request(ctx, config.jiraClient, "POST", workflowAPIEndpoint, workflow, returnedWorkflow)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira workflow failed", nil)
+	}
+
+	d.SetId(returnedWorkflow.Name)
+
+	return resourceWorkflowRead(ctx, d, m)
+}
+
+// resourceWorkflowRead reads workflow details using jira api
+func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	workflow, err := getWorkflow(ctx, config.jiraClient, d.Id())
+	if err != nil {
+		return errorDiagnostics(err, "reading jira workflow failed", nil)
+	}
+	if workflow == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", workflow.ID.Name)
+	d.Set("entity_id", workflow.ID.EntityID)
+	d.Set("description", workflow.Description)
+
+	// Workflows defined by definition_json are kept as configured
+	if _, ok := d.GetOk("definition_json"); !ok {
+		statuses := make([]interface{}, 0, len(workflow.Statuses))
+		for _, status := range workflow.Statuses {
+			statuses = append(statuses, map[string]interface{}{
+				"status_id":  status.ID,
+				"properties": status.Properties,
+			})
+		}
+
+		transitions, err := flattenWorkflowTransitions(workflow.Transitions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Imported workflows are read completely
+		if len(d.Get("transition").([]interface{})) > 0 {
+			normalizeWorkflow(statuses, transitions, d)
+		}
+
+		d.Set("status", statuses)
+		d.Set("transition", transitions)
+	}
+
+	return nil
+}
+
+// resourceWorkflowDelete deletes jira workflow using the jira api
+func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", workflowEndpoint(d.Get("entity_id").(string)), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira workflow failed", nil)
+	}
+
+	return nil
+}
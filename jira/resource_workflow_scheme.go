@@ -0,0 +1,279 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// WorkflowScheme The struct sent to and returned by the JIRA instance to manage workflow schemes
+type WorkflowScheme struct {
+	ID                  int               `json:"id,omitempty"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description"`
+	DefaultWorkflow     string            `json:"defaultWorkflow,omitempty"`
+	IssueTypeMappings   map[string]string `json:"issueTypeMappings"`
+	Draft               bool              `json:"draft,omitempty"`
+	UpdateDraftIfNeeded bool              `json:"updateDraftIfNeeded,omitempty"`
+}
+
+// WorkflowSchemeStatusMapping maps a status of an issue type to a status of
+// the new workflow
+type WorkflowSchemeStatusMapping struct {
+	IssueTypeID string `json:"issueTypeId"`
+	StatusID    string `json:"statusId"`
+	NewStatusID string `json:"newStatusId"`
+}
+
+// workflowStatusMappingSchema returns the schema of the status mappings used
+// when issues are migrated to other workflows
+func workflowStatusMappingSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"issue_type_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "ID of the issue type",
+				},
+				"status_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "ID of the status in the current workflow",
+				},
+				"new_status_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "ID of the status in the new workflow",
+				},
+			},
+		},
+	}
+}
+
+func expandWorkflowStatusMappings(v interface{}) []WorkflowSchemeStatusMapping {
+	mappings := []WorkflowSchemeStatusMapping{}
+	for _, mapping := range v.([]interface{}) {
+		m := mapping.(map[string]interface{})
+		mappings = append(mappings, WorkflowSchemeStatusMapping{
+			IssueTypeID: m["issue_type_id"].(string),
+			StatusID:    m["status_id"].(string),
+			NewStatusID: m["new_status_id"].(string),
+		})
+	}
+	return mappings
+}
+
+// resourceWorkflowScheme is used to define a JIRA workflow scheme
+func resourceWorkflowScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkflowSchemeCreate,
+		ReadContext:   resourceWorkflowSchemeRead,
+		UpdateContext: resourceWorkflowSchemeUpdate,
+		DeleteContext: resourceWorkflowSchemeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Read:   schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+
+		Description: "Creates a workflow scheme, which maps issue types to workflows. The ID can be used as workflow_scheme of jira_project",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the workflow scheme",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the workflow scheme",
+			},
+			"default_workflow": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the workflow used by issue types without mapping. Defaults to jira, the system workflow",
+			},
+			"issue_type_mappings": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Maps the IDs of issue types to the names of their workflows",
+			},
+			"status_mapping": workflowStatusMappingSchema("Statuses the issues are moved to, if a change of a scheme used by projects " +
+				"moves issues to workflows without their current status"),
+		},
+	}
+}
+
+func workflowSchemeEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", workflowSchemeAPIEndpoint, id)
+}
+
+func expandWorkflowScheme(d *schema.ResourceData) *WorkflowScheme {
+	scheme := &WorkflowScheme{
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		DefaultWorkflow:   d.Get("default_workflow").(string),
+		IssueTypeMappings: expandStringMap(d.Get("issue_type_mappings")),
+	}
+	// Mappings missing in an update are removed
+	if scheme.IssueTypeMappings == nil {
+		scheme.IssueTypeMappings = map[string]string{}
+	}
+	return scheme
+}
+
+// getProjectWorkflowScheme returns the workflow scheme of the project, or nil
+// if JIRA doesn't report it
+func getProjectWorkflowScheme(ctx context.Context, client *jira.Client, projectID string) (*WorkflowScheme, error) {
+	query := url.Values{}
+	query.Set("projectId", projectID)
+
+	associations := new(struct {
+		Values []struct {
+			WorkflowScheme WorkflowScheme `json:"workflowScheme"`
+		} `json:"values"`
+	})
+	urlStr := fmt.Sprintf("%s/project?%s", workflowSchemeAPIEndpoint, query.Encode())
+	err := request(ctx, client, "GET", urlStr, nil, associations)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(associations.Values) == 0 {
+		return nil, nil
+	}
+	return &associations.Values[0].WorkflowScheme, nil
+}
+
+// switchProjectWorkflowScheme assigns the workflow scheme to the project and
+// migrates the issues using the status mappings
+func switchProjectWorkflowScheme(ctx context.Context, client *jira.Client, projectID string, schemeID int, mappings []WorkflowSchemeStatusMapping) error {
+	type statusMapping struct {
+		OldStatusID string `json:"oldStatusId"`
+		NewStatusID string `json:"newStatusId"`
+	}
+	type issueTypeMappings struct {
+		IssueTypeID    string          `json:"issueTypeId"`
+		StatusMappings []statusMapping `json:"statusMappings"`
+	}
+
+	// JIRA expects the mappings grouped by issue type
+	overrides := []*issueTypeMappings{}
+	index := make(map[string]*issueTypeMappings)
+	for _, mapping := range mappings {
+		override, ok := index[mapping.IssueTypeID]
+		if !ok {
+			override = &issueTypeMappings{IssueTypeID: mapping.IssueTypeID}
+			index[mapping.IssueTypeID] = override
+			overrides = append(overrides, override)
+		}
+		override.StatusMappings = append(override.StatusMappings, statusMapping{
+			OldStatusID: mapping.StatusID,
+			NewStatusID: mapping.NewStatusID,
+		})
+	}
+
+	body := map[string]interface{}{
+		"projectId":                   projectID,
+		"targetSchemeId":              strconv.Itoa(schemeID),
+		"mappingsByIssueTypeOverride": overrides,
+	}
+	return requestTask(ctx, client, "POST", workflowSchemeAPIEndpoint+"/project/switch", body)
+}
+
+// resourceWorkflowSchemeCreate creates a new jira workflow scheme using the jira api
+func resourceWorkflowSchemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	returnedScheme := new(WorkflowScheme)
+	err := request(ctx, config.jiraClient, "POST", workflowSchemeAPIEndpoint, expandWorkflowScheme(d), returnedScheme)
+	if err != nil {
+		return errorDiagnostics(err, "creating jira workflow scheme failed", nil)
+	}
+
+	d.SetId(strconv.Itoa(returnedScheme.ID))
+
+	return resourceWorkflowSchemeRead(ctx, d, m)
+}
+
+// resourceWorkflowSchemeRead reads workflow scheme details using jira api
+func resourceWorkflowSchemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	scheme := new(WorkflowScheme)
+	err := request(ctx, config.jiraClient, "GET", workflowSchemeEndpoint(d.Id()), nil, scheme)
+	if err != nil {
+		if errors.Is(err, ResourceNotFoundError) {
+			d.SetId("")
+			return nil
+		}
+		return errorDiagnostics(err, "reading jira workflow scheme failed", nil)
+	}
+
+	d.Set("name", scheme.Name)
+	d.Set("description", scheme.Description)
+	d.Set("default_workflow", scheme.DefaultWorkflow)
+	d.Set("issue_type_mappings", scheme.IssueTypeMappings)
+
+	return nil
+}
+
+// resourceWorkflowSchemeUpdate updates jira workflow scheme using jira api
+func resourceWorkflowSchemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	if d.HasChanges("name", "description", "default_workflow", "issue_type_mappings") {
+		// Schemes used by projects can only be changed using a draft
+		scheme := expandWorkflowScheme(d)
+		scheme.UpdateDraftIfNeeded = true
+
+		returnedScheme := new(WorkflowScheme)
+		err := request(ctx, config.jiraClient, "PUT", workflowSchemeEndpoint(d.Id()), scheme, returnedScheme)
+		if err != nil {
+			return errorDiagnostics(err, "updating jira workflow scheme failed", nil)
+		}
+
+		if returnedScheme.Draft {
+			body := map[string]interface{}{
+				"statusMappings": expandWorkflowStatusMappings(d.Get("status_mapping")),
+			}
+			urlStr := fmt.Sprintf("%s/draft/publish", workflowSchemeEndpoint(d.Id()))
+			if err := requestTask(ctx, config.jiraClient, "POST", urlStr, body); err != nil {
+				return errorDiagnostics(err, "publishing jira workflow scheme failed", nil)
+			}
+		}
+	}
+
+	return resourceWorkflowSchemeRead(ctx, d, m)
+}
+
+// resourceWorkflowSchemeDelete deletes jira workflow scheme using the jira api
+func resourceWorkflowSchemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*Config)
+
+	err := request(ctx, config.jiraClient, "DELETE", workflowSchemeEndpoint(d.Id()), nil, nil)
+	if err != nil {
+		return errorDiagnostics(err, "deleting jira workflow scheme failed", nil)
+	}
+
+	return nil
+}
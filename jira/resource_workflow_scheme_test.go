@@ -0,0 +1,274 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+)
+
+func TestAccJiraWorkflowScheme_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_workflow_scheme.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraWorkflowSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraWorkflowSchemeConfig(rInt, "foo", "foo", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("foo-scheme-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "default_workflow", "jira"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.%", "1"),
+					resource.TestCheckResourceAttrPair("jira_project.foo", "workflow_scheme", resourceName, "id"),
+					testAccCheckJiraIssueStatus("jira_issue.foo", "1"),
+				),
+			},
+			{
+				// The issue is moved from Open to Done, because the new workflow
+				// doesn't contain Open
+				Config: testAccJiraWorkflowSchemeConfig(rInt, "bar", "foo", `
+  status_mapping {
+    issue_type_id = jira_issue_type.foo.id
+    status_id     = "1"
+    new_status_id = "10000"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowSchemeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("bar-scheme-%d", rInt)),
+					testAccCheckJiraIssueStatus("jira_issue.foo", "10000"),
+				),
+			},
+			{
+				// Switching to the scheme bar moves the issue back from Done to Open
+				Config: testAccJiraWorkflowSchemeConfig(rInt, "bar", "bar", `
+  status_mapping {
+    issue_type_id = jira_issue_type.foo.id
+    status_id     = "1"
+    new_status_id = "10000"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jira_project.foo", "workflow_scheme", "jira_workflow_scheme.bar", "id"),
+					testAccCheckJiraIssueStatus("jira_issue.foo", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status_mapping"},
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflowScheme_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_workflow_scheme.foo"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraWorkflowSchemeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraWorkflowSchemeDeletedConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceID(resourceName, &id),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", workflowSchemeEndpoint(id), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraWorkflowSchemeDeletedConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowSchemeExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraWorkflowSchemeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_workflow_scheme" {
+			continue
+		}
+
+		err := request(context.Background(), client, "GET", workflowSchemeEndpoint(rs.Primary.ID), nil, nil)
+		if errors.Is(err, ResourceNotFoundError) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Workflow scheme %q still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccCheckJiraWorkflowSchemeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No workflow scheme ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		return request(context.Background(), client, "GET", workflowSchemeEndpoint(rs.Primary.ID), nil, nil)
+	}
+}
+
+// testAccCheckJiraIssueStatus checks the status of the issue in JIRA, which
+// changes without the issue being updated when it is migrated
+func testAccCheckJiraIssueStatus(n string, statusID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		issue := new(jira.Issue)
+		err := request(context.Background(), client, "GET", fmt.Sprintf("%s/%s", issueAPIEndpoint, rs.Primary.ID), nil, issue)
+		if err != nil {
+			return err
+		}
+		if issue.Fields.Status.ID != statusID {
+			return fmt.Errorf("Issue %s has status %s, expected %s", rs.Primary.ID, issue.Fields.Status.ID, statusID)
+		}
+		return nil
+	}
+}
+
+func testAccJiraWorkflowSchemeDeletedConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_workflow_scheme" "foo" {
+  name = "foo-scheme-%d"
+}
+`, rInt)
+}
+
+// The scheme foo maps the issue type to the workflow with the same name, the
+// project uses the scheme projectScheme
+func testAccJiraWorkflowSchemeConfig(rInt int, name string, projectScheme string, statusMappings string) string {
+	return fmt.Sprintf(`
+resource "jira_issue_type" "foo" {
+  name = "workflow-type-%d"
+}
+
+resource "jira_workflow" "foo" {
+  name = "foo-workflow-%d"
+
+  status {
+    status_id = "1"
+  }
+
+  status {
+    status_id = "3"
+  }
+
+  transition {
+    name = "Create"
+    type = "initial"
+    to   = "1"
+  }
+
+  transition {
+    name = "In Progress"
+    type = "global"
+    to   = "3"
+  }
+}
+
+resource "jira_workflow" "bar" {
+  name = "bar-workflow-%d"
+
+  status {
+    status_id = "3"
+  }
+
+  status {
+    status_id = "10000"
+  }
+
+  transition {
+    name = "Create"
+    type = "initial"
+    to   = "3"
+  }
+
+  transition {
+    name = "Done"
+    type = "global"
+    to   = "10000"
+  }
+}
+
+resource "jira_workflow_scheme" "foo" {
+  name        = "%s-scheme-%d"
+  description = "Created by Terraform"
+
+  issue_type_mappings = {
+    (jira_issue_type.foo.id) = jira_workflow.%s.name
+  }
+%s
+}
+
+resource "jira_workflow_scheme" "bar" {
+  name = "other-scheme-%d"
+
+  issue_type_mappings = {
+    (jira_issue_type.foo.id) = jira_workflow.foo.name
+  }
+}
+
+resource "jira_user" "foo" {
+  name  = "project-user-%d"
+  email = "example@example.org"
+}
+
+resource "jira_project" "foo" {
+  name                 = "foo-name-%d"
+  key                  = "PX%d"
+  lead                 = jira_user.foo.name
+  project_type_key     = "software"
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+  workflow_scheme      = jira_workflow_scheme.%s.id
+
+  workflow_status_mapping {
+    issue_type_id = jira_issue_type.foo.id
+    status_id     = "10000"
+    new_status_id = "1"
+  }
+}
+
+resource "jira_issue" "foo" {
+  issue_type  = jira_issue_type.foo.name
+  project_key = jira_project.foo.key
+  summary     = "Created using Terraform"
+}
+`, rInt, rInt, rInt, name, rInt, name, statusMappings, rInt, rInt, rInt, rInt%100000, projectScheme)
+}
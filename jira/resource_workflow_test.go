@@ -0,0 +1,217 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraWorkflow_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_workflow.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraWorkflowConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("foo-workflow-%d", rInt)),
+					resource.TestCheckResourceAttrSet(resourceName, "entity_id"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "transition.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "transition.1.condition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "transition.1.condition_operator", "OR"),
+					resource.TestCheckResourceAttr(resourceName, "transition.1.post_function.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transition.1.post_function.0.type", "AssignToCurrentUserFunction"),
+					resource.TestCheckResourceAttrSet(resourceName, "transition.1.id"),
+				),
+			},
+			{
+				Config: testAccJiraWorkflowConfig(rInt, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("bar-workflow-%d", rInt)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The import reads the essential post functions added by Jira
+				ImportStateVerifyIgnore: []string{
+					"transition.0.post_function",
+					"transition.1.post_function",
+					"transition.2.post_function",
+				},
+			},
+			{
+				Config: testAccJiraWorkflowDefinitionConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflow_deleted(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "jira_workflow.foo"
+	var entityID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckJiraWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraWorkflowConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStoreResourceAttr(resourceName, "entity_id", &entityID),
+				),
+			},
+			{
+				PreConfig: func() {
+					jiraClient := testAccProvider.Meta().(*Config).jiraClient
+					err := request(context.Background(), jiraClient, "DELETE", workflowEndpoint(entityID), nil, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccJiraWorkflowConfig(rInt, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJiraWorkflowExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJiraWorkflowDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).jiraClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jira_workflow" {
+			continue
+		}
+
+		workflow, err := getWorkflow(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if workflow != nil {
+			return fmt.Errorf("Workflow %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckJiraWorkflowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No workflow ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).jiraClient
+		workflow, err := getWorkflow(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if workflow == nil {
+			return fmt.Errorf("Workflow %q does not exist", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// The tests use the IDs of the statuses Open (1), In Progress (3) and Done (10000)
+func testAccJiraWorkflowConfig(rInt int, name string) string {
+	return fmt.Sprintf(`
+resource "jira_workflow" "foo" {
+  name        = "%s-workflow-%d"
+  description = "Created by Terraform"
+
+  status {
+    status_id = "1"
+  }
+
+  status {
+    status_id = "3"
+    properties = {
+      "jira.issue.editable" = "true"
+    }
+  }
+
+  status {
+    status_id = "10000"
+  }
+
+  transition {
+    name = "Create"
+    type = "initial"
+    to   = "1"
+  }
+
+  transition {
+    name               = "Start Progress"
+    from               = ["1"]
+    to                 = "3"
+    condition_operator = "OR"
+
+    condition {
+      type          = "PermissionCondition"
+      configuration = jsonencode({ permissionKey = "WORK_ON_ISSUES" })
+    }
+
+    condition {
+      type = "OnlyAssigneeCondition"
+    }
+
+    validator {
+      type          = "PermissionValidator"
+      configuration = jsonencode({ permissionKey = "ASSIGN_ISSUES" })
+    }
+
+    post_function {
+      type = "AssignToCurrentUserFunction"
+    }
+  }
+
+  transition {
+    name = "Done"
+    type = "global"
+    to   = "10000"
+  }
+}
+`, name, rInt)
+}
+
+func testAccJiraWorkflowDefinitionConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "jira_workflow" "foo" {
+  name = "definition-workflow-%d"
+
+  definition_json = jsonencode({
+    statuses = [{ id = "1" }, { id = "10000" }]
+    transitions = [
+      { name = "Create", type = "initial", from = [], to = "1" },
+      { name = "Done", type = "directed", from = ["1"], to = "10000" },
+    ]
+  })
+}
+`, rInt)
+}
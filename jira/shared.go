@@ -1,10 +1,14 @@
 package jira
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"reflect"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	jira "github.com/andygrunwald/go-jira"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,25 +16,65 @@ import (
 )
 
 // API Endpoints
+const attachmentAPIEndpoint = "/rest/api/2/attachment"
 const componentAPIEndpoint = "rest/api/2/component"
+const fieldAPIEndpoint = "/rest/api/2/field"
+const fieldConfigurationAPIEndpoint = "/rest/api/2/fieldconfiguration"
+const fieldConfigurationSchemeAPIEndpoint = "/rest/api/2/fieldconfigurationscheme"
 const filterAPIEndpoint = "/rest/api/2/filter"
 const groupAPIEndpoint = "/rest/api/2/group"
 const groupUserAPIEndpoint = "/rest/api/2/group/user"
 
+const issueAPIEndpoint = "/rest/api/2/issue"
+const issueV3APIEndpoint = "/rest/api/3/issue"
+const issueSecuritySchemeAPIEndpoint = "/rest/api/2/issuesecurityschemes"
 const issueLinkAPIEndpoint = "/rest/api/2/issueLink"
 const issueLinkTypeAPIEndpoint = "/rest/api/2/issueLinkType"
 const issueTypeAPIEndpoint = "/rest/api/2/issuetype"
+const issueTypeSchemeAPIEndpoint = "/rest/api/2/issuetypescheme"
+const issueTypeScreenSchemeAPIEndpoint = "/rest/api/2/issuetypescreenscheme"
 
+const notificationSchemeAPIEndpoint = "/rest/api/2/notificationscheme"
+const permissionSchemeAPIEndpoint = "/rest/api/2/permissionscheme"
+const priorityAPIEndpoint = "/rest/api/2/priority"
+const prioritySchemeAPIEndpoint = "/rest/api/2/priorityschemes"
 const projectAPIEndpoint = "/rest/api/2/project"
 const projectCategoryAPIEndpoint = "/rest/api/2/projectCategory"
+const resolutionAPIEndpoint = "/rest/api/2/resolution"
 const roleAPIEndpoint = "/rest/api/2/role"
+const screenAPIEndpoint = "/rest/api/2/screens"
+const screenSchemeAPIEndpoint = "/rest/api/2/screenscheme"
+const statusAPIEndpoint = "/rest/api/2/status"
+const statusesAPIEndpoint = "/rest/api/2/statuses"
+const taskAPIEndpoint = "/rest/api/2/task"
 const userAPIEndpoint = "/rest/api/2/user"
+const versionAPIEndpoint = "/rest/api/2/version"
 const webhookAPIEndpoint = "/rest/webhooks/1.0/webhook"
+const workflowAPIEndpoint = "/rest/api/2/workflow"
+const workflowSchemeAPIEndpoint = "/rest/api/2/workflowscheme"
+
+// defaultTimeout applies to all operations unless the resource specifies a different
+// default. It can be overridden by users using the timeouts block.
+const defaultTimeout = 5 * time.Minute
 
 func projectWithSharedConfigurationAPIEndpoint(projectID int) string {
 	return fmt.Sprintf("/rest/project-templates/1.0/createshared/%d", projectID)
 }
 
+func issueEndpoint(issueIDOrKey string) string {
+	return fmt.Sprintf("%s/%s", issueAPIEndpoint, issueIDOrKey)
+}
+
+// issueV3Endpoint returns the endpoint of the issue in version 3 of the API,
+// which exchanges rich text in Atlassian Document Format
+func issueV3Endpoint(issueIDOrKey string) string {
+	return fmt.Sprintf("%s/%s", issueV3APIEndpoint, issueIDOrKey)
+}
+
+func issueTransitionsEndpoint(issueIDOrKey string) string {
+	return fmt.Sprintf("%s/%s/transitions", issueAPIEndpoint, issueIDOrKey)
+}
+
 func projectRoleAPIEndpoint(projectKey string) string {
 	return fmt.Sprintf("/rest/api/2/project/%s/role", projectKey)
 }
@@ -60,9 +104,9 @@ var (
 	ResourceNotFoundError = &resourceNotFoundError{} // Constant to use with errors.Is and errors.As
 )
 
-func request(client *jira.Client, method string, endpoint string, in interface{}, out interface{}) error {
+func request(ctx context.Context, client *jira.Client, method string, endpoint string, in interface{}, out interface{}) error {
 
-	req, err := client.NewRequest(method, endpoint, in)
+	req, err := client.NewRequestWithContext(ctx, method, endpoint, in)
 
 	if err != nil {
 		return errors.Wrapf(err, "Creating %s Request failed", method)
@@ -70,23 +114,159 @@ func request(client *jira.Client, method string, endpoint string, in interface{}
 
 	res, err := client.Do(req, out)
 	if err != nil {
-		if in != nil && res != nil {
-			typeName := reflect.TypeOf(in).Name()
-			body, readErr := ioutil.ReadAll(res.Response.Body)
-			if readErr != nil {
-				return errors.Wrapf(readErr, "Creating %s Request failed", typeName)
+		return newAPIError(method, endpoint, res, err)
+	}
+
+	return nil
+}
+
+// taskPollInterval is the time between two checks of a running task
+var taskPollInterval = 2 * time.Second
+
+// jiraTask is a long running task of JIRA
+type jiraTask struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// requestTask starts a long running task and waits until it finished. JIRA
+// redirects to the task, once it has been started.
+func requestTask(ctx context.Context, client *jira.Client, method string, endpoint string, in interface{}) error {
+	task := new(jiraTask)
+	if err := request(ctx, client, method, endpoint, in, task); err != nil {
+		return err
+	}
+
+	for task.Status == "ENQUEUED" || task.Status == "RUNNING" || task.Status == "CANCEL_REQUESTED" {
+		timer := time.NewTimer(taskPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if err := request(ctx, client, "GET", fmt.Sprintf("%s/%s", taskAPIEndpoint, task.ID), nil, task); err != nil {
+			return err
+		}
+	}
+
+	if task.Status != "COMPLETE" {
+		return fmt.Errorf("task %s of %s %s finished with status %s: %s", task.ID, method, endpoint, task.Status, task.Message)
+	}
+	return nil
+}
+
+// pageBean is a page of values as returned by the paginated endpoints
+type pageBean struct {
+	StartAt    int               `json:"startAt"`
+	MaxResults int               `json:"maxResults"`
+	Total      int               `json:"total"`
+	IsLast     bool              `json:"isLast"`
+	Values     []json.RawMessage `json:"values"`
+}
+
+// requestPages fetches all pages of a paginated endpoint and calls value for
+// each value
+func requestPages(ctx context.Context, client *jira.Client, endpoint string, query url.Values, value func(json.RawMessage) error) error {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	for startAt := 0; ; {
+		query.Set("startAt", strconv.Itoa(startAt))
+
+		page := new(pageBean)
+		err := request(ctx, client, "GET", fmt.Sprintf("%s?%s", endpoint, query.Encode()), nil, page)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Values {
+			if err := value(v); err != nil {
+				return errors.Wrapf(err, "Decoding %s failed", endpoint)
 			}
-			return errors.Wrapf(err, "Creating %s Request failed: %s", typeName, body)
 		}
 
-		if res.StatusCode == 404 {
-			return &resourceNotFoundError{err}
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 {
+			return nil
 		}
+	}
+}
 
-		return errors.Wrapf(err, "Creating Request failed")
+// setToStrings returns the sorted elements of a set of strings
+func setToStrings(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
 	}
+	sort.Strings(values)
+	return values
+}
 
-	return nil
+// expandStringList converts a list attribute to a slice of strings
+func expandStringList(list []interface{}) []string {
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		values = append(values, v.(string))
+	}
+	return values
+}
+
+// expandStringMap converts a map attribute to a map of strings
+func expandStringMap(v interface{}) map[string]string {
+	m, _ := v.(map[string]interface{})
+	if len(m) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(m))
+	for key, value := range m {
+		result[key] = value.(string)
+	}
+	return result
+}
+
+// nullableString returns nil for an empty string, which is sent as null to
+// clear the value
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsStringFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDuration(val interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(val.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s needs to be a duration like 500ms or 10s: %s", k, err)}
+	}
+	return nil, nil
+}
+
+func validateDate(val interface{}, k string) ([]string, []error) {
+	if _, err := time.Parse("2006-01-02", val.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s needs to be a date like 2021-05-31: %s", k, err)}
+	}
+	return nil, nil
 }
 
 func caseInsensitiveSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
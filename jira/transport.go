@@ -0,0 +1,155 @@
+package jira
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent by Jira Cloud when rate limiting is in place
+const rateLimitRemainingHeader = "X-RateLimit-Remaining"
+const rateLimitResetHeader = "X-RateLimit-Reset"
+
+// retryTransport is an http.RoundTripper that retries requests which were
+// rejected because JIRA is rate limiting or temporarily unavailable.
+type retryTransport struct {
+	// MaxRetries is the number of retries after the initial attempt
+	MaxRetries int
+
+	// BaseBackoff is the delay before the first retry. It doubles with each attempt.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff. Delays requested by JIRA
+	// using Retry-After or X-RateLimit-Reset are honored even if they are longer.
+	MaxBackoff time.Duration
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			// The body has been consumed by the previous attempt
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.transport().RoundTrip(attemptReq)
+
+		if attempt >= t.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		if resp != nil {
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s (%d/%d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt+1, t.MaxRetries)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s (%d/%d)", req.Method, req.URL.Path, err, wait, attempt+1, t.MaxRetries)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry decides whether a request may be sent again. Requests which
+// might have been processed by JIRA are only retried if they are idempotent.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return req.Context().Err() == nil && isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// backoff returns the delay before the next attempt
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := t.BaseBackoff << uint(attempt)
+	if wait > t.MaxBackoff || wait <= 0 {
+		wait = t.MaxBackoff
+	}
+
+	// Add up to 25% jitter so concurrent resources don't retry in lockstep
+	if wait > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait)/4 + 1))
+	}
+
+	if resp == nil {
+		return wait
+	}
+
+	if requested, ok := retryAfter(resp.Header, time.Now()); ok && requested > wait {
+		return requested
+	}
+
+	return wait
+}
+
+// retryAfter extracts the delay requested by JIRA from the response headers
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return at.Sub(now), true
+		}
+	}
+
+	if h.Get(rateLimitRemainingHeader) == "0" {
+		v := h.Get(rateLimitResetHeader)
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00"} {
+			if at, err := time.Parse(layout, v); err == nil {
+				return at.Sub(now), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+func (t *retryTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
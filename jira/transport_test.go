@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRetryClient(maxRetries int) *http.Client {
+	return &http.Client{Transport: &retryTransport{
+		MaxRetries:  maxRetries,
+		BaseBackoff: time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}}
+}
+
+func TestRetryTransport_retriesRateLimitedRequests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"foo"}` {
+			t.Errorf("attempt %d: unexpected body %q", attempts, body)
+		}
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"name":"foo"}`))
+	resp, err := testRetryClient(4).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_givesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	resp, err := testRetryClient(2).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_doesNotRetryNonIdempotentGatewayErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	_, err := testRetryClient(2).Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2021, 5, 12, 8, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		header   http.Header
+		expected time.Duration
+		ok       bool
+	}{
+		{http.Header{"Retry-After": []string{"7"}}, 7 * time.Second, true},
+		{http.Header{"Retry-After": []string{"Wed, 12 May 2021 08:30:10 GMT"}}, 10 * time.Second, true},
+		{http.Header{
+			"X-Ratelimit-Remaining": []string{"0"},
+			"X-Ratelimit-Reset":     []string{"2021-05-12T08:32Z"},
+		}, 2 * time.Minute, true},
+		{http.Header{
+			"X-Ratelimit-Remaining": []string{"10"},
+			"X-Ratelimit-Reset":     []string{"2021-05-12T08:32Z"},
+		}, 0, false},
+		{http.Header{}, 0, false},
+	}
+
+	for _, c := range cases {
+		d, ok := retryAfter(c.header, now)
+		if ok != c.ok || d != c.expected {
+			t.Errorf("retryAfter(%v) = %s, %t; expected %s, %t", c.header, d, ok, c.expected, c.ok)
+		}
+	}
+}